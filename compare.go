@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/y-moriya/steam-review/internal/api"
+	"github.com/y-moriya/steam-review/internal/logger"
+	"github.com/y-moriya/steam-review/internal/stats"
+	"github.com/y-moriya/steam-review/internal/storage"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// loadComparison 比較対象（保存済みJSONファイル・App ID・ゲーム名）から比較用の指標を計算
+func loadComparison(ctx context.Context, target string, cfg config.Config, log *logger.Logger) (stats.GameComparison, error) {
+	// 保存済みのJSONファイル
+	if info, err := os.Stat(target); err == nil && !info.IsDir() {
+		reviews, gameDetails, err := storage.LoadReviewsFromFile(target)
+		if err != nil {
+			return stats.GameComparison{}, err
+		}
+		gameName := strings.TrimSuffix(filepath.Base(target), filepath.Ext(target))
+		appID := ""
+		if gameDetails != nil {
+			gameName = gameDetails.Name
+			appID = gameDetails.AppID
+		}
+		return stats.ComputeGameComparison(reviews, gameName, appID, nil), nil
+	}
+
+	ft := fetchTarget{name: target}
+	gameName := target
+	if isAppID(target) {
+		ft = fetchTarget{appID: target}
+		gameName = fmt.Sprintf("App ID %s", target)
+	}
+	reviews, appID, err := fetchTargetReviews(ctx, ft, cfg, log)
+	if err != nil {
+		return stats.GameComparison{}, err
+	}
+
+	if gameDetails, err := api.GetGameDetailsContext(ctx, appID, log); err != nil {
+		log.Warn(i18n.Tf(i18n.MsgErrorGameDetailsInit, err), "appid", appID)
+	} else {
+		gameName = gameDetails.Name
+	}
+
+	// Steam公式の評価は全言語の集計を使う
+	summary, err := api.GetReviewSummaryContext(ctx, appID, nil, config.FilterAll)
+	if err != nil {
+		log.Warn(i18n.Tf(i18n.MsgErrorReviewFetch, err), "appid", appID)
+		summary = nil
+	}
+
+	return stats.ComputeGameComparison(reviews, gameName, appID, summary), nil
+}
+
+// runCompare compare コマンド: 複数のゲームの統計を横並びで比較
+func runCompare(ctx context.Context, args []string) error {
+	var cfg config.Config
+	var languageStr string
+	var csvFile string
+
+	fs := newFlagSet("compare", &cfg)
+	fs.IntVar(&cfg.MaxReviews, "max", 100, "ゲームごとの最大取得レビュー数 (0で無制限)")
+	fs.StringVar(&languageStr, "lang", "japanese", "取得する言語 (カンマ区切り, デフォルト: japanese)")
+	fs.StringVar(&cfg.Filter, "filter", config.FilterAll, "レビューのフィルター (recent, updated, all)")
+	fs.StringVar(&csvFile, "csv", "", "比較結果を保存するCSVファイル")
+	if err := parseFlags(fs, args, &cfg); err != nil {
+		return err
+	}
+
+	targets := fs.Args()
+	if len(targets) < 2 {
+		return newUsageError(i18n.T(i18n.MsgErrorCompareTargets))
+	}
+	cfg.Languages = ParseLanguages(languageStr)
+	if err := cfg.Validate(); err != nil {
+		return usageError{err: err}
+	}
+	cfg.Languages = config.NormalizeLanguages(cfg.Languages)
+
+	log, err := newLogger(cfg)
+	if err != nil {
+		return err
+	}
+	defer log.Close()
+
+	var games []stats.GameComparison
+	for _, target := range targets {
+		log.Info(i18n.Tf(i18n.MsgCompareLoading, target))
+		gc, err := loadComparison(ctx, target, cfg, log)
+		if err != nil {
+			return logError(log, wrapFailure(err, i18n.Tf(i18n.MsgErrorCompareTarget, target, err)))
+		}
+		games = append(games, gc)
+	}
+
+	stats.PrintComparison(os.Stdout, games)
+
+	if csvFile != "" {
+		file, err := os.Create(csvFile)
+		if err != nil {
+			return logError(log, newFailure(exitWrite, errors.New(i18n.Tf(i18n.MsgErrorFileSave, err))))
+		}
+		err = stats.WriteComparisonCSV(file, games)
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return logError(log, newFailure(exitWrite, errors.New(i18n.Tf(i18n.MsgErrorFileSave, err))))
+		}
+		log.Info(i18n.Tf(i18n.MsgCompareCSVSaved, csvFile))
+	}
+
+	return nil
+}
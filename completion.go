@@ -0,0 +1,546 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/y-moriya/steam-review/internal/api"
+	"github.com/y-moriya/steam-review/internal/stats"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+const (
+	// programName 補完スクリプトを登録するコマンド名
+	programName = "steam-review"
+	// appListCacheFile ゲーム名の補完に使うアプリ一覧のファイル名（ユーザーキャッシュディレクトリ内）
+	appListCacheFile = "apps.txt"
+	// maxGameCompletions 補完候補として表示するゲーム名の最大数
+	maxGameCompletions = 100
+)
+
+// completionKind 値の候補が決まっていないフラグ・引数の補完方法
+type completionKind int
+
+const (
+	completeNone  completionKind = iota // 補完しない
+	completeFiles                       // ファイル名
+	completeDirs                        // ディレクトリ名
+	completeGames                       // 保存済みのアプリ一覧のゲーム名
+)
+
+// completionFlag 補完するフラグ
+type completionFlag struct {
+	name   string
+	isBool bool
+	values []string       // 値の候補
+	list   bool           // 値をカンマ区切りで複数指定できるかどうか
+	kind   completionKind // 値の候補がない場合の補完方法
+}
+
+// completionCommand 補完するサブコマンド
+type completionCommand struct {
+	name    string
+	summary string
+	flags   []completionFlag
+	args    []string       // 引数の候補
+	argKind completionKind // 引数の候補がない場合の補完方法
+}
+
+// completionFlagKinds 値としてファイル名・ディレクトリ名・ゲーム名を補完するフラグ
+var completionFlagKinds = map[string]completionKind{
+	"config":           completeFiles,
+	"stats-file":       completeFiles,
+	"summary-file":     completeFiles,
+	"stopwords":        completeFiles,
+	"webhook-template": completeFiles,
+	"file":             completeFiles,
+	"csv":              completeFiles,
+	"output":           completeDirs,
+	"lexicon-dir":      completeDirs,
+	"game":             completeGames,
+	"games":            completeGames,
+}
+
+// completionListFlags 値をカンマ区切りで複数指定できるフラグ
+var completionListFlags = map[string]bool{"lang": true, "notify-on": true, "games": true}
+
+// completionArgKinds サブコマンドの引数の補完方法
+var completionArgKinds = map[string]completionKind{
+	"stats":   completeFiles,
+	"export":  completeFiles,
+	"compare": completeFiles,
+	"search":  completeGames,
+	"details": completeGames,
+}
+
+// completionFlagValues 値の候補が決まっているフラグとその候補
+func completionFlagValues() map[string][]string {
+	languages := []string{config.LanguageAll}
+	for _, lang := range config.Languages {
+		languages = append(languages, lang.Code)
+	}
+	var locales []string
+	for locale := range i18n.SupportedLanguages {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+
+	return map[string][]string{
+		"filter":         {config.FilterAll, config.FilterRecent, config.FilterUpdated},
+		"format":         {config.ExportFormatText, config.ExportFormatJSON, config.ExportFormatCSV, config.ExportFormatJSONL},
+		"stats-format":   {config.StatsFormatText, config.StatsFormatJSON, config.StatsFormatCSV},
+		"chart-style":    {stats.ChartStyleUnicode, stats.ChartStyleASCII},
+		"log-level":      {config.LogLevelDebug, config.LogLevelInfo, config.LogLevelWarn, config.LogLevelError},
+		"log-format":     {config.LogFormatText, config.LogFormatJSON},
+		"webhook-format": {config.WebhookFormatJSON, config.WebhookFormatSlack, config.WebhookFormatDiscord},
+		"notify-on":      {config.NotifyNew, config.NotifyNegative, config.NotifyKeywords},
+		"lang":           languages,
+		"locale":         locales,
+	}
+}
+
+// completionArgs 引数の候補が決まっているサブコマンドとその候補
+func completionArgs() map[string][]string {
+	var names []string
+	for _, cmd := range commands() {
+		names = append(names, cmd.name)
+	}
+	return map[string][]string{
+		"help":       names,
+		"config":     {"show"},
+		"completion": {"bash", "zsh", "fish", "apps"},
+	}
+}
+
+// errFlagsCollected flagSetCollector が設定されている場合に parseFlags が返すエラー
+var errFlagsCollected = errors.New("flags collected")
+
+// flagSetCollector nil でない場合、parseFlags はフラグを解析せずにフラグセットを渡して errFlagsCollected を返す
+var flagSetCollector func(*flag.FlagSet)
+
+// commandFlagSet サブコマンドを実行せずに、そのフラグセットを取得
+func commandFlagSet(cmd command) *flag.FlagSet {
+	var fs *flag.FlagSet
+	flagSetCollector = func(f *flag.FlagSet) { fs = f }
+	defer func() { flagSetCollector = nil }()
+
+	var args []string
+	if cmd.name == "config" {
+		args = []string{"show"}
+	}
+	cmd.run(context.Background(), args)
+	return fs
+}
+
+// completionCommands すべてのサブコマンドのフラグと引数の補完方法
+func completionCommands() []completionCommand {
+	values := completionFlagValues()
+	args := completionArgs()
+
+	var cmds []completionCommand
+	for _, cmd := range commands() {
+		cc := completionCommand{name: cmd.name, summary: i18n.T(cmd.summary), args: args[cmd.name], argKind: completionArgKinds[cmd.name]}
+		if fs := commandFlagSet(cmd); fs != nil {
+			fs.VisitAll(func(f *flag.Flag) {
+				b, ok := f.Value.(interface{ IsBoolFlag() bool })
+				cc.flags = append(cc.flags, completionFlag{
+					name:   f.Name,
+					isBool: ok && b.IsBoolFlag(),
+					values: values[f.Name],
+					list:   completionListFlags[f.Name],
+					kind:   completionFlagKinds[f.Name],
+				})
+			})
+		}
+		cmds = append(cmds, cc)
+	}
+	return cmds
+}
+
+// appListCachePath ゲーム名の補完に使うアプリ一覧の保存先
+func appListCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, config.UserConfigDir, appListCacheFile), nil
+}
+
+// saveAppList Steamのアプリ一覧を取得し、ゲーム名を1行1件で保存して件数を返す
+func saveAppList(ctx context.Context, path string) (int, error) {
+	apps, err := api.GetAppListContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var names []string
+	seen := make(map[string]bool)
+	for _, app := range apps {
+		name := strings.Join(strings.Fields(app.Name), " ")
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return 0, err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strings.Join(names, "\n")+"\n"), 0644); err != nil {
+		os.Remove(tmp)
+		return 0, err
+	}
+	return len(names), os.Rename(tmp, path)
+}
+
+// runCompletion completion コマンド: シェルの補完スクリプトを出力、またはゲーム名の補完用にアプリ一覧を保存
+func runCompletion(ctx context.Context, args []string) error {
+	var cfg config.Config
+	fs := newFlagSet("completion", &cfg)
+	if err := parseFlags(fs, args, &cfg); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return newUsageError(i18n.T(i18n.MsgErrorCompletionShell))
+	}
+
+	// アプリ一覧の保存先がわからない場合、補完スクリプトはゲーム名を補完しない
+	appsFile, pathErr := appListCachePath()
+
+	shell := fs.Arg(0)
+	switch shell {
+	case "apps":
+		if pathErr != nil {
+			return errors.New(i18n.Tf(i18n.MsgErrorCompletionApps, pathErr))
+		}
+		count, err := saveAppList(ctx, appsFile)
+		if err != nil {
+			return errors.New(i18n.Tf(i18n.MsgErrorCompletionApps, err))
+		}
+		if !cfg.Quiet {
+			fmt.Fprintln(os.Stderr, i18n.Tf(i18n.MsgCompletionAppsSaved, count, appsFile))
+		}
+		return nil
+	case "bash":
+		fmt.Print(bashCompletion(completionCommands(), appsFile))
+	case "zsh":
+		fmt.Print(zshCompletion(completionCommands(), appsFile))
+	case "fish":
+		fmt.Print(fishCompletion(completionCommands(), appsFile))
+	default:
+		return newUsageError(i18n.Tf(i18n.MsgErrorCompletionShellUnknown, shell))
+	}
+	return nil
+}
+
+// shellQuote 文字列を sh のシングルクォートで囲む（bash, zsh で使用）
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// fishQuote 文字列を fish のシングルクォートで囲む
+func fishQuote(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", `\'`).Replace(s) + "'"
+}
+
+// flagNames サブコマンドのフラグ名（"-" 付き）
+func flagNames(cmd completionCommand) []string {
+	var names []string
+	for _, f := range cmd.flags {
+		names = append(names, "-"+f.name)
+	}
+	return names
+}
+
+// valueFlags すべてのサブコマンドの値を取るフラグ（同名のフラグは最初のもの）
+func valueFlags(cmds []completionCommand) []completionFlag {
+	var flags []completionFlag
+	seen := make(map[string]bool)
+	for _, cmd := range cmds {
+		for _, f := range cmd.flags {
+			if f.isBool || seen[f.name] {
+				continue
+			}
+			seen[f.name] = true
+			flags = append(flags, f)
+		}
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].name < flags[j].name })
+	return flags
+}
+
+// bashCompletion bash の補完スクリプト
+func bashCompletion(cmds []completionCommand, appsFile string) string {
+	var b strings.Builder
+	var names []string
+	for _, cmd := range cmds {
+		names = append(names, cmd.name)
+	}
+
+	fmt.Fprintf(&b, "# bash completion for %s (generated by \"%s completion bash\")\n", programName, programName)
+	fmt.Fprintf(&b, "# Add to ~/.bashrc: source <(%s completion bash)\n\n", programName)
+	fmt.Fprintf(&b, "_steam_review_apps_file=%s\n\n", shellQuote(appsFile))
+	b.WriteString(`# Complete game names starting with the current word from the saved app list
+_steam_review_games() {
+    local cur="${1#[\"\']}" name
+    cur="${cur//\\/}"
+    [[ -r $_steam_review_apps_file ]] || return
+    local IFS=$'\n'
+    for name in $(awk -v p="${cur,,}" 'index(tolower($0), p) == 1' "$_steam_review_apps_file" | head -n ` + fmt.Sprint(maxGameCompletions) + `); do
+        COMPREPLY+=("$(printf '%q' "$name")")
+    done
+}
+
+# Complete the last element of a comma-separated value
+_steam_review_values() {
+    local prefix=""
+    [[ $cur == *,* ]] && prefix="${cur%,*},"
+    COMPREPLY=($(compgen -P "$prefix" -W "$1" -- "${cur##*,}"))
+}
+
+_steam_review() {
+    local cur="${COMP_WORDS[COMP_CWORD]}" prev="${COMP_WORDS[COMP_CWORD-1]}" cmd
+    COMPREPLY=()
+    if [[ $prev == "=" && $COMP_CWORD -ge 2 ]]; then
+        prev="${COMP_WORDS[COMP_CWORD-2]}"
+    fi
+
+`)
+	b.WriteString("    # Flags without a subcommand are fetch flags\n")
+	fmt.Fprintf(&b, "    if [[ $COMP_CWORD -eq 1 ]]; then\n")
+	fmt.Fprintf(&b, "        if [[ $cur == -* ]]; then\n")
+	fmt.Fprintf(&b, "            cmd=fetch\n")
+	fmt.Fprintf(&b, "        else\n")
+	fmt.Fprintf(&b, "            COMPREPLY=($(compgen -W %s -- \"$cur\"))\n", shellQuote(strings.Join(names, " ")))
+	fmt.Fprintf(&b, "            return\n")
+	fmt.Fprintf(&b, "        fi\n")
+	fmt.Fprintf(&b, "    elif [[ ${COMP_WORDS[1]} == -* ]]; then\n")
+	fmt.Fprintf(&b, "        cmd=fetch\n")
+	fmt.Fprintf(&b, "    else\n")
+	fmt.Fprintf(&b, "        cmd=${COMP_WORDS[1]}\n")
+	fmt.Fprintf(&b, "    fi\n\n")
+
+	// 直前のフラグの値
+	b.WriteString("    local p=\"${prev#-}\"\n")
+	b.WriteString("    [[ $prev == -* ]] && case \"${p#-}\" in\n")
+	for _, f := range valueFlags(cmds) {
+		fmt.Fprintf(&b, "        %s)\n", f.name)
+		switch {
+		case len(f.values) > 0:
+			fmt.Fprintf(&b, "            _steam_review_values %s\n", shellQuote(strings.Join(f.values, " ")))
+		case f.kind == completeFiles:
+			b.WriteString("            compopt -o filenames 2>/dev/null\n")
+			b.WriteString("            COMPREPLY=($(compgen -f -- \"$cur\"))\n")
+		case f.kind == completeDirs:
+			b.WriteString("            compopt -o filenames 2>/dev/null\n")
+			b.WriteString("            COMPREPLY=($(compgen -d -- \"$cur\"))\n")
+		case f.kind == completeGames:
+			b.WriteString("            _steam_review_games \"$cur\"\n")
+		}
+		b.WriteString("            return\n")
+		b.WriteString("            ;;\n")
+	}
+	b.WriteString("    esac\n\n")
+
+	// フラグと引数
+	b.WriteString("    local flags\n")
+	b.WriteString("    case $cmd in\n")
+	for _, cmd := range cmds {
+		fmt.Fprintf(&b, "        %s)\n", cmd.name)
+		fmt.Fprintf(&b, "            flags=%s\n", shellQuote(strings.Join(flagNames(cmd), " ")))
+		if cmd.name != "fetch" {
+			b.WriteString("            if [[ $cur != -* ]]; then\n")
+			switch {
+			case len(cmd.args) > 0:
+				b.WriteString("                [[ $COMP_CWORD -eq 2 ]] || return\n")
+				fmt.Fprintf(&b, "                COMPREPLY=($(compgen -W %s -- \"$cur\"))\n", shellQuote(strings.Join(cmd.args, " ")))
+			case cmd.argKind == completeFiles:
+				b.WriteString("                compopt -o filenames 2>/dev/null\n")
+				b.WriteString("                COMPREPLY=($(compgen -f -- \"$cur\"))\n")
+			case cmd.argKind == completeGames:
+				b.WriteString("                _steam_review_games \"$cur\"\n")
+			}
+			b.WriteString("                return\n")
+			b.WriteString("            fi\n")
+		}
+		b.WriteString("            ;;\n")
+	}
+	b.WriteString("        *)\n")
+	b.WriteString("            return\n")
+	b.WriteString("            ;;\n")
+	b.WriteString("    esac\n")
+	b.WriteString("    COMPREPLY=($(compgen -W \"$flags\" -- \"$cur\"))\n")
+	b.WriteString("}\n\n")
+	fmt.Fprintf(&b, "complete -F _steam_review %s\n", programName)
+	return b.String()
+}
+
+// zshCompletion zsh の補完スクリプト
+func zshCompletion(cmds []completionCommand, appsFile string) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "#compdef %s\n", programName)
+	fmt.Fprintf(&b, "# zsh completion for %s (generated by \"%s completion zsh\")\n", programName, programName)
+	fmt.Fprintf(&b, "# Save as _%s in a directory of $fpath, or add to ~/.zshrc: source <(%s completion zsh)\n\n", programName, programName)
+	fmt.Fprintf(&b, "_steam_review_apps_file=%s\n\n", shellQuote(appsFile))
+	b.WriteString(`# Complete game names starting with the current word from the saved app list
+_steam_review_games() {
+    [[ -r $_steam_review_apps_file ]] || return 1
+    local -a names
+    names=(${(f)"$(awk -v p="${(L)PREFIX}" 'index(tolower($0), p) == 1' "$_steam_review_apps_file" | head -n ` + fmt.Sprint(maxGameCompletions) + `)"})
+    compadd -a names
+}
+
+_steam_review() {
+    local -a commands
+    commands=(
+`)
+	for _, cmd := range cmds {
+		fmt.Fprintf(&b, "        %s\n", shellQuote(cmd.name+":"+strings.ReplaceAll(cmd.summary, ":", `\:`)))
+	}
+	b.WriteString(`    )
+
+    # Flags without a subcommand are fetch flags
+    local cmd=fetch
+    if (( CURRENT == 2 )) && [[ $PREFIX != -* ]]; then
+        _describe -t commands 'command' commands
+        return
+    elif [[ $words[2] != -* ]]; then
+        cmd=$words[2]
+        shift words
+        (( CURRENT-- ))
+    fi
+
+    case $cmd in
+`)
+	for _, cmd := range cmds {
+		fmt.Fprintf(&b, "        %s)\n", cmd.name)
+		b.WriteString("            _arguments -S")
+		for _, f := range cmd.flags {
+			fmt.Fprintf(&b, " \\\n                %s", shellQuote(zshFlagSpec(f)))
+		}
+		switch {
+		case len(cmd.args) > 0:
+			fmt.Fprintf(&b, " \\\n                %s", shellQuote("1:argument:("+strings.Join(cmd.args, " ")+")"))
+		case cmd.argKind == completeFiles:
+			fmt.Fprintf(&b, " \\\n                %s", shellQuote("*:file:_files"))
+		case cmd.argKind == completeGames:
+			fmt.Fprintf(&b, " \\\n                %s", shellQuote("*:game:_steam_review_games"))
+		}
+		b.WriteString("\n            ;;\n")
+	}
+	b.WriteString("    esac\n")
+	b.WriteString("}\n\n")
+	b.WriteString("if [[ $zsh_eval_context[-1] == loadautofunc ]]; then\n")
+	b.WriteString("    _steam_review \"$@\"\n")
+	b.WriteString("else\n")
+	fmt.Fprintf(&b, "    compdef _steam_review %s\n", programName)
+	b.WriteString("fi\n")
+	return b.String()
+}
+
+// zshFlagSpec _arguments に渡すフラグの指定
+func zshFlagSpec(f completionFlag) string {
+	spec := "-" + f.name
+	if f.isBool {
+		return spec
+	}
+	switch {
+	case len(f.values) > 0 && f.list:
+		return spec + ":" + f.name + ":_sequence compadd - " + strings.Join(f.values, " ")
+	case len(f.values) > 0:
+		return spec + ":" + f.name + ":(" + strings.Join(f.values, " ") + ")"
+	case f.kind == completeFiles:
+		return spec + ":file:_files"
+	case f.kind == completeDirs:
+		return spec + ":directory:_files -/"
+	case f.kind == completeGames:
+		return spec + ":game:_steam_review_games"
+	default:
+		return spec + ":" + f.name + ": "
+	}
+}
+
+// fishCompletion fish の補完スクリプト
+func fishCompletion(cmds []completionCommand, appsFile string) string {
+	var b strings.Builder
+	var names []string
+	for _, cmd := range cmds {
+		names = append(names, cmd.name)
+	}
+
+	fmt.Fprintf(&b, "# fish completion for %s (generated by \"%s completion fish\")\n", programName, programName)
+	fmt.Fprintf(&b, "# Save as ~/.config/fish/completions/%s.fish\n\n", programName)
+	fmt.Fprintf(&b, "set -g __steam_review_apps_file %s\n\n", fishQuote(appsFile))
+	b.WriteString(`# Complete game names starting with the current word from the saved app list
+function __steam_review_games
+    test -r "$__steam_review_apps_file"; or return
+    set -l p (string lower -- (commandline -ct))
+    awk -v p="$p" 'index(tolower($0), p) == 1' $__steam_review_apps_file | head -n ` + fmt.Sprint(maxGameCompletions) + `
+end
+
+# Whether the subcommand is one of the arguments (fetch when omitted)
+function __steam_review_using_command
+    set -l tokens (commandline -opc)
+    set -l cmd fetch
+    if test (count $tokens) -ge 2; and not string match -q -- '-*' $tokens[2]
+        set cmd $tokens[2]
+    end
+    contains -- $cmd $argv
+end
+
+# Whether the current word is the first argument of the subcommand
+function __steam_review_first_arg
+    test (count (commandline -opc)) -eq 2
+end
+
+`)
+	fmt.Fprintf(&b, "complete -c %s -f\n", programName)
+	for _, cmd := range cmds {
+		fmt.Fprintf(&b, "complete -c %s -n 'test (count (commandline -opc)) -eq 1' -a %s -d %s\n", programName, cmd.name, fishQuote(cmd.summary))
+	}
+	for _, cmd := range cmds {
+		b.WriteString("\n")
+		cond := fishQuote("__steam_review_using_command " + cmd.name)
+		for _, f := range cmd.flags {
+			fmt.Fprintf(&b, "complete -c %s -n %s -o %s", programName, cond, f.name)
+			switch {
+			case f.isBool:
+			case len(f.values) > 0:
+				fmt.Fprintf(&b, " -x -a %s", fishQuote(strings.Join(f.values, " ")))
+			case f.kind == completeFiles:
+				b.WriteString(" -r -F")
+			case f.kind == completeDirs:
+				b.WriteString(" -x -a '(__fish_complete_directories (commandline -ct))'")
+			case f.kind == completeGames:
+				b.WriteString(" -x -a '(__steam_review_games)'")
+			default:
+				b.WriteString(" -x")
+			}
+			b.WriteString("\n")
+		}
+		if cmd.name == "fetch" {
+			continue
+		}
+		switch {
+		case len(cmd.args) > 0:
+			argCond := fishQuote("__steam_review_using_command " + cmd.name + "; and __steam_review_first_arg")
+			fmt.Fprintf(&b, "complete -c %s -n %s -a %s\n", programName, argCond, fishQuote(strings.Join(cmd.args, " ")))
+		case cmd.argKind == completeFiles:
+			fmt.Fprintf(&b, "complete -c %s -n %s -F\n", programName, cond)
+		case cmd.argKind == completeGames:
+			fmt.Fprintf(&b, "complete -c %s -n %s -a '(__steam_review_games)'\n", programName, cond)
+		}
+	}
+	return b.String()
+}
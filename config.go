@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// runConfig config コマンド: 設定ファイルに関する操作
+func runConfig(_ context.Context, args []string) error {
+	if len(args) == 0 || args[0] != "show" {
+		if len(args) > 0 && (args[0] == "-help" || args[0] == "--help" || args[0] == "-h") {
+			printCommandUsage("config", nil)
+			return nil
+		}
+		return newUsageError(i18n.T(i18n.MsgErrorConfigCommand))
+	}
+	return runConfigShow(args[1:])
+}
+
+// newConfigShowFlagSet 設定ファイルで指定できるすべてのキーのフラグを登録した config show のフラグセットを作成
+//
+// fetch のフラグに、watch・export だけのフラグを加える（max, lang など共通の名前は fetch のデフォルト値を表示する）。
+func newConfigShowFlagSet(cfg *config.Config) *flag.FlagSet {
+	var ff fetchFlags
+	var appIDs, format string
+
+	fs := newFlagSet("config", cfg)
+	registerFetchFlags(fs, cfg, &ff)
+	registerWatchFlags(fs, cfg, &appIDs)
+	registerExportFlags(fs, &format)
+	return fs
+}
+
+// runConfigShow config show コマンド: 設定ファイル・プロファイル・フラグを反映した設定を表示
+func runConfigShow(args []string) error {
+	var cfg config.Config
+	fs := newConfigShowFlagSet(&cfg)
+	sources, err := parseFlagsWithSources(fs, args, &cfg)
+	if err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return newUsageError(i18n.Tf(i18n.MsgErrorUnknownCommand, fs.Arg(0)))
+	}
+
+	none := i18n.T(i18n.MsgConfigNone)
+	configFile, profile := cfg.ConfigFile, cfg.Profile
+	if configFile == "" {
+		configFile = none
+	}
+	if profile == "" {
+		profile = none
+	}
+	fmt.Println(i18n.Tf(i18n.MsgConfigFile, configFile))
+	fmt.Println(i18n.Tf(i18n.MsgConfigProfile, profile))
+	fmt.Println()
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s\t%s\t%s\n", i18n.T(i18n.MsgConfigKey), i18n.T(i18n.MsgConfigValue), i18n.T(i18n.MsgConfigSource))
+	for _, key := range config.FileKeys {
+		f := fs.Lookup(key)
+		if f == nil {
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", key, f.Value.String(), sources[key])
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+
+	"github.com/y-moriya/steam-review/internal/api"
+	"github.com/y-moriya/steam-review/internal/storage"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// runDetails details コマンド: ゲームの詳細情報を表示
+func runDetails(ctx context.Context, args []string) error {
+	var cfg config.Config
+	var outputJSON bool
+
+	fs := newFlagSet("details", &cfg)
+	fs.BoolVar(&outputJSON, "json", false, "詳細情報をJSON形式で表示")
+	if err := parseFlags(fs, args, &cfg); err != nil {
+		return err
+	}
+
+	target := strings.TrimSpace(strings.Join(fs.Args(), " "))
+	if target == "" {
+		return newUsageError(i18n.T(i18n.MsgErrorNoInput))
+	}
+
+	appID := target
+	if !isAppID(target) {
+		var err error
+		appID, err = resolveAppID(ctx, target)
+		if err != nil {
+			return err
+		}
+	}
+
+	gameDetails, err := api.GetGameDetailsContext(ctx, appID, nil)
+	if err != nil {
+		return wrapFailure(apiFailure(err), i18n.Tf(i18n.MsgErrorGameDetailsInit, err))
+	}
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(gameDetails)
+	}
+	storage.WriteGameDetails(os.Stdout, gameDetails)
+	return nil
+}
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/y-moriya/steam-review/internal/api"
+	"github.com/y-moriya/steam-review/internal/storage"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// languagePlaceholder 言語別に保存する場合に、取得するまで言語がわからないファイル名の言語部分
+const languagePlaceholder = "<language>"
+
+// fetchPlan ドライランで表示する1つのゲームの取得計画
+type fetchPlan struct {
+	appID    string
+	name     string        // ゲーム名で指定した場合のゲーム名
+	url      string        // 最初のページのリクエストURL
+	total    int           // 最初のページの集計情報 (query_summary.total_reviews)
+	reviews  int           // 取得する見込みのレビュー数
+	limited  bool          // -max により取得数が制限されるかどうか
+	pages    int           // 見込みのリクエスト数
+	latency  time.Duration // 最初のページの取得にかかった時間
+	duration time.Duration // 所要時間の見込み
+	files    []string      // 書き込まれるファイル
+}
+
+// estimateFetch 取得可能なレビュー数と最大取得数から、取得するレビュー数・リクエスト数・所要時間を見積もる
+//
+// 所要時間は、1リクエストあたり latency かかるものとし、リクエストの間にレート制限対策の待ち時間を加える。
+func estimateFetch(total, maxReviews int, latency time.Duration) (reviews, pages int, duration time.Duration) {
+	reviews = total
+	if maxReviews > 0 && reviews > maxReviews {
+		reviews = maxReviews
+	}
+	pages = max((reviews+api.ReviewsPerPage-1)/api.ReviewsPerPage, 1)
+	duration = time.Duration(pages)*latency + time.Duration(pages-1)*api.RequestInterval
+	return reviews, pages, duration
+}
+
+// plannedFiles fetch が書き込むファイルの一覧
+//
+// -split で全言語を取得する場合、言語ごとのファイル名は取得するまでわからないため言語部分を languagePlaceholder にする。
+func plannedFiles(appID string, cfg config.Config) []string {
+	baseFilename := reviewsBaseFilename(appID, cfg)
+
+	var files []string
+	if cfg.SplitByLang {
+		languages := cfg.Languages
+		if len(languages) == 0 || slices.Contains(languages, config.LanguageAll) {
+			languages = []string{languagePlaceholder}
+		}
+		for _, lang := range languages {
+			files = append(files, storage.LanguageFilename(baseFilename, cfg.OutputDir, lang, cfg.OutputJSON))
+		}
+		files = append(files, storage.LanguageFilename(baseFilename, cfg.OutputDir, storage.AllLanguages, cfg.OutputJSON))
+	} else {
+		filename := baseFilename
+		if cfg.OutputDir != "" {
+			filename = cfg.OutputDir + "/" + filename
+		}
+		files = append(files, filename)
+	}
+	if cfg.ExportUnanswered {
+		files = append(files, unansweredFilename(appID, cfg))
+	}
+	if cfg.Keywords {
+		files = append(files, keywordsFilename(appID, cfg))
+	}
+	if cfg.StatsFile != "" {
+		files = append(files, statsFilename(appID, cfg))
+	}
+	return files
+}
+
+// planFetch ゲームを解決して最初のページを取得し、その集計情報から取得計画を作成
+func planFetch(ctx context.Context, target fetchTarget, cfg config.Config) (fetchPlan, error) {
+	plan := fetchPlan{appID: target.appID, name: target.name}
+	if plan.appID == "" {
+		appID, err := resolveAppID(ctx, target.name)
+		if err != nil {
+			return plan, err
+		}
+		plan.appID = appID
+	}
+
+	// fetch と同じ最初のページのリクエストを送信する（取得したレビューは使わない）
+	plan.url = api.ReviewsURL(plan.appID, api.FirstCursor, api.ReviewsPerPage, cfg.Filter, cfg.Languages)
+	start := time.Now()
+	resp, err := api.FetchReviewsFromSteamContext(ctx, plan.appID, api.FirstCursor, api.ReviewsPerPage, cfg.Filter, cfg.Languages)
+	if err != nil {
+		return plan, newFailure(exitNetwork, errors.New(i18n.Tf(i18n.MsgErrorReviewFetch, err)))
+	}
+	plan.latency = time.Since(start)
+
+	plan.total = resp.QuerySummary.TotalReviews
+	plan.reviews, plan.pages, plan.duration = estimateFetch(plan.total, cfg.MaxReviews, plan.latency)
+	plan.limited = cfg.MaxReviews > 0 && plan.total > cfg.MaxReviews
+	plan.files = plannedFiles(plan.appID, cfg)
+	return plan, nil
+}
+
+// print 取得計画を表示
+func (p fetchPlan) print(w io.Writer) {
+	if p.name != "" {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgDryRunGameName, p.name, p.appID))
+	} else {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgDryRunGameAppID, p.appID))
+	}
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgDryRunRequest, p.url))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgDryRunTotal, p.total))
+	if p.limited {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgDryRunReviewsMax, p.reviews))
+	} else {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgDryRunReviews, p.reviews))
+	}
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgDryRunPages, p.pages, api.ReviewsPerPage))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgDryRunDuration, p.duration.Round(time.Second), p.latency.Round(time.Millisecond), api.RequestInterval))
+	fmt.Fprintln(w, i18n.T(i18n.MsgDryRunFiles))
+	for _, file := range p.files {
+		fmt.Fprintf(w, "    - %s\n", file)
+	}
+}
+
+// dryRunFetch -dry-run: 取得対象のゲームごとに取得計画を表示する（レビューの保存・通知・ログファイルの作成は行わない）
+func dryRunFetch(ctx context.Context, targets []fetchTarget, cfg config.Config) error {
+	fmt.Println(i18n.T(i18n.MsgDryRunHeader))
+
+	var failedCodes []int
+	for _, target := range targets {
+		fmt.Println()
+		plan, err := planFetch(ctx, target, cfg)
+		if err != nil {
+			if len(targets) == 1 || ctx.Err() != nil {
+				return err
+			}
+			fmt.Fprintln(os.Stderr, i18n.Tf(i18n.MsgErrorCompareTarget, target.String(), err))
+			failedCodes = append(failedCodes, errorExitCode(err))
+			continue
+		}
+		plan.print(os.Stdout)
+	}
+	if len(failedCodes) > 0 {
+		return fetchGamesError(failedCodes, len(targets))
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/internal/storage"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// writeExport レビューを export の出力形式で w に書き込む
+func writeExport(w io.Writer, format string, reviews []models.ReviewData, gameDetails *models.GameDetails) error {
+	switch format {
+	case config.ExportFormatCSV:
+		return storage.WriteReviewsCSV(w, reviews)
+	case config.ExportFormatJSONL:
+		return storage.WriteReviewsJSONL(w, reviews)
+	default:
+		return storage.WriteReviews(w, reviews, format == config.ExportFormatJSON, gameDetails, nil)
+	}
+}
+
+// registerExportFlags export だけの設定ファイルで指定できるフラグを登録（config show でも使用）
+func registerExportFlags(fs *flag.FlagSet, format *string) {
+	fs.StringVar(format, "format", config.ExportFormatCSV, "出力形式 (text, json, csv, jsonl)")
+}
+
+// runExport export コマンド: 保存済みのJSONファイルを別の形式で書き出す
+func runExport(_ context.Context, args []string) error {
+	var cfg config.Config
+	var format string
+	var filename string
+
+	fs := newFlagSet("export", &cfg)
+	registerExportFlags(fs, &format)
+	fs.StringVar(&filename, "file", "", "出力ファイル (- で標準出力。デフォルト: <出力ディレクトリ>/<入力ファイル名>.<拡張子>、標準入力から読み込む場合は標準出力)")
+	if err := parseFlags(fs, args, &cfg); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return newUsageError(i18n.T(i18n.MsgErrorNoInputFiles))
+	}
+
+	var ext string
+	switch format {
+	case config.ExportFormatText:
+		ext = config.FileExtTXT
+	case config.ExportFormatJSON:
+		ext = config.FileExtJSON
+	case config.ExportFormatCSV:
+		ext = config.FileExtCSV
+	case config.ExportFormatJSONL:
+		ext = config.FileExtJSONL
+	default:
+		return newUsageError(i18n.Tf(i18n.MsgErrorExportFormat, format))
+	}
+
+	if filename == "" {
+		if slices.Contains(fs.Args(), stdinArg) {
+			filename = stdinArg
+		} else {
+			base := strings.TrimSuffix(filepath.Base(fs.Arg(0)), filepath.Ext(fs.Arg(0)))
+			filename = filepath.Join(cfg.OutputDir, base+ext)
+		}
+	}
+	toStdout := filename == stdinArg
+	for _, input := range fs.Args() {
+		if !toStdout && filepath.Clean(input) == filepath.Clean(filename) {
+			return newUsageError(i18n.Tf(i18n.MsgErrorExportOverwrite, filename))
+		}
+	}
+
+	log, err := newLogger(cfg)
+	if err != nil {
+		return err
+	}
+	defer log.Close()
+	if toStdout {
+		log.LogToStderr()
+	}
+
+	reviews, gameDetails, err := loadReviewFiles(fs.Args(), os.Stdin)
+	if err != nil {
+		return logError(log, err)
+	}
+
+	if toStdout {
+		if err := writeExport(os.Stdout, format, reviews, gameDetails); err != nil {
+			return logError(log, newFailure(exitWrite, errors.New(i18n.Tf(i18n.MsgErrorFileSave, err))))
+		}
+		log.Debug(i18n.Tf(i18n.MsgExportWritten, len(reviews)))
+		return nil
+	}
+
+	if dir := filepath.Dir(filename); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return logError(log, newFailure(exitWrite, errors.New(i18n.Tf(i18n.MsgErrorDirCreation, err))))
+		}
+	}
+	if err := exportToFile(filename, format, reviews, gameDetails); err != nil {
+		return logError(log, newFailure(exitWrite, errors.New(i18n.Tf(i18n.MsgErrorFileSave, err))))
+	}
+
+	log.Info(i18n.Tf(i18n.MsgExportSaved, len(reviews), filename))
+	return nil
+}
+
+// exportToFile レビューを export の出力形式でファイルに保存
+func exportToFile(filename, format string, reviews []models.ReviewData, gameDetails *models.GameDetails) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf(i18n.T(i18n.MsgFileCreationError), err)
+	}
+	if err := writeExport(file, format, reviews, gameDetails); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
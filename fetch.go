@@ -0,0 +1,658 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/y-moriya/steam-review/internal/api"
+	"github.com/y-moriya/steam-review/internal/logger"
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/internal/notify"
+	"github.com/y-moriya/steam-review/internal/progress"
+	"github.com/y-moriya/steam-review/internal/sentiment"
+	"github.com/y-moriya/steam-review/internal/stats"
+	"github.com/y-moriya/steam-review/internal/storage"
+	"github.com/y-moriya/steam-review/internal/term"
+	"github.com/y-moriya/steam-review/internal/text"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// statsFlags 統計関連のフラグのうち、Config に直接格納しないもの
+type statsFlags struct {
+	playtimeBuckets string
+}
+
+// registerStatsFlags fetch と stats で共通の統計フラグを登録
+func registerStatsFlags(fs *flag.FlagSet, cfg *config.Config, sf *statsFlags) {
+	fs.StringVar(&sf.playtimeBuckets, "playtime-buckets", "1,5,20,100", "統計のプレイ時間区分の境界 (時間単位, カンマ区切り)")
+	fs.BoolVar(&cfg.Keywords, "keywords", false, "肯定的・否定的レビューに特徴的な語とバイグラムを分析")
+	fs.IntVar(&cfg.KeywordsTop, "keywords-top", 20, "キーワード分析で表示する上位語数")
+	fs.StringVar(&cfg.StopwordsFile, "stopwords", "", "キーワード分析で追加除外するストップワードのファイル (1行1語)")
+	fs.BoolVar(&cfg.Sentiment, "sentiment", false, "辞書ベースで本文の感情スコアを計算し、出力と統計に含める")
+	fs.StringVar(&cfg.LexiconDir, "lexicon-dir", "", "感情辞書のディレクトリ (<Steam言語コード>.tsv で同梱の辞書を置き換え)")
+	fs.IntVar(&cfg.TopHelpful, "top-helpful", stats.DefaultTopHelpful, "統計に表示する最も有用な肯定的・否定的レビューの件数")
+	fs.StringVar(&cfg.StatsFormat, "stats-format", config.StatsFormatText, "統計の出力形式 (text, json, csv)")
+	fs.StringVar(&cfg.StatsFile, "stats-file", "", "統計の出力先ファイル (デフォルト: 標準出力)")
+	fs.BoolVar(&cfg.Charts, "charts", false, "統計にチャートを表示 (標準出力が端末の場合のみ)")
+	fs.StringVar(&cfg.ChartStyle, "chart-style", stats.ChartStyleUnicode, "チャートの文字セット (unicode, ascii)")
+}
+
+// statsOptions 統計フラグを検証し、統計オプションと感情スコアの計算に使う Analyzer を作成
+//
+// 辞書の問題でレビューの取得後に失敗しないように、-sentiment の辞書はここで読み込む。
+// -sentiment を指定しない場合の Analyzer は nil。
+func statsOptions(cfg *config.Config, sf statsFlags) (stats.Options, *sentiment.Analyzer, error) {
+	var err error
+	cfg.PlaytimeBuckets, err = stats.ParsePlaytimeBuckets(sf.playtimeBuckets)
+	if err != nil {
+		return stats.Options{}, nil, usageError{err: err}
+	}
+
+	if cfg.ChartStyle != stats.ChartStyleUnicode && cfg.ChartStyle != stats.ChartStyleASCII {
+		return stats.Options{}, nil, newUsageError(i18n.Tf(i18n.MsgErrorChartStyle, cfg.ChartStyle))
+	}
+
+	var analyzer *sentiment.Analyzer
+	if cfg.Sentiment {
+		analyzer, err = newAnalyzer(cfg.LexiconDir)
+		if err != nil {
+			return stats.Options{}, nil, err
+		}
+	}
+
+	opts := stats.DefaultOptions()
+	opts.PlaytimeBuckets = cfg.PlaytimeBuckets
+	opts.Keywords = cfg.Keywords
+	opts.Sentiment = cfg.Sentiment
+	opts.TopHelpful = cfg.TopHelpful
+	opts.KeywordOptions.TopN = cfg.KeywordsTop
+	if cfg.StopwordsFile != "" {
+		if err := text.LoadStopwords(cfg.StopwordsFile, opts.KeywordOptions.Stopwords); err != nil {
+			return stats.Options{}, nil, errors.New(i18n.Tf(i18n.MsgErrorStopwordsLoad, err))
+		}
+	}
+	return opts, analyzer, nil
+}
+
+// newAnalyzer 同梱の辞書と -lexicon-dir の辞書を読み込んだ Analyzer を作成
+func newAnalyzer(lexiconDir string) (*sentiment.Analyzer, error) {
+	analyzer, err := sentiment.NewAnalyzer()
+	if err == nil && lexiconDir != "" {
+		err = analyzer.LoadLexiconDir(lexiconDir)
+		if errors.Is(err, sentiment.ErrNoLexicons) {
+			return nil, newUsageError(i18n.Tf(i18n.MsgErrorLexiconDir, lexiconDir))
+		}
+	}
+	if err != nil {
+		return nil, errors.New(i18n.Tf(i18n.MsgErrorSentimentInit, err))
+	}
+	return analyzer, nil
+}
+
+// annotateSentiment 感情スコアを計算してレビューに付与
+func annotateSentiment(reviews []models.ReviewData, analyzer *sentiment.Analyzer, log *logger.Logger) {
+	scored := analyzer.Annotate(reviews)
+	log.Debug(i18n.Tf(i18n.MsgVerboseSentimentScored, scored, len(reviews)))
+}
+
+// saveKeywordsCSV キーワード分析の結果をCSVファイルに保存
+func saveKeywordsCSV(ks stats.KeywordStats, filename string) (err error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	return stats.WriteKeywordsCSV(file, ks)
+}
+
+// writeStats 設定された形式と出力先に統計を書き込む
+func writeStats(rs stats.ReviewStats, cfg config.Config, log *logger.Logger) (err error) {
+	if cfg.StatsFile == "" {
+		if cfg.StatsFormat == config.StatsFormatText {
+			// 統計はコマンドの結果のため、-quiet でも標準出力に表示する
+			stats.Print(os.Stdout, rs)
+			if cfg.Charts && term.IsTerminal(os.Stdout) {
+				stats.PrintCharts(os.Stdout, rs, stats.ChartOptions{Width: term.Width(os.Stdout), Style: cfg.ChartStyle})
+			}
+			return nil
+		}
+		return stats.Write(os.Stdout, rs, cfg.StatsFormat)
+	}
+
+	file, err := os.Create(cfg.StatsFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	if err := stats.Write(file, rs, cfg.StatsFormat); err != nil {
+		return err
+	}
+	log.Debug(i18n.Tf(i18n.MsgVerboseStatsSaved, cfg.StatsFile))
+	return nil
+}
+
+// fetchStats fetch のすべてのゲームで共通する統計の設定
+type fetchStats struct {
+	opts     stats.Options
+	analyzer *sentiment.Analyzer // -sentiment を指定しない場合は nil
+	stdout   *stats.StreamWriter // 統計をJSON・CSVで標準出力に書き出す場合のみ
+}
+
+// fetchFlags fetch のフラグのうち、Config に直接格納しないもの
+type fetchFlags struct {
+	languages   string
+	games       string
+	showVersion bool
+	dryRun      bool
+	stats       statsFlags
+	notify      notifyFlags
+}
+
+// registerFetchFlags fetch のフラグを登録（config show でも使用）
+func registerFetchFlags(fs *flag.FlagSet, cfg *config.Config, ff *fetchFlags) {
+	fs.StringVar(&cfg.AppID, "appid", "", "Steam App ID")
+	fs.StringVar(&cfg.GameName, "game", "", "ゲーム名")
+	fs.StringVar(&ff.games, "games", "", "取得するゲームの一覧 (App IDまたはゲーム名, カンマ区切り)")
+	fs.IntVar(&cfg.MaxReviews, "max", 100, "最大取得レビュー数 (0で無制限)")
+	fs.StringVar(&ff.languages, "lang", "japanese", "取得する言語 (カンマ区切り, デフォルト: japanese)")
+	fs.StringVar(&cfg.Filter, "filter", config.FilterAll,
+		"レビューのフィルター (recent: 作成日時順, updated: 更新日時順, all: 有用性順(デフォルト))")
+	fs.BoolVar(&cfg.SplitByLang, "split", false, "言語別にファイルを分けて保存")
+	fs.BoolVar(&cfg.OutputJSON, "json", false, "出力ファイルをJSON形式(.json)にする (デフォルト: テキスト形式)")
+	fs.BoolVar(&cfg.ExportUnanswered, "unanswered", false, "開発者が未返信の否定的レビューを有用性順で別ファイルに保存")
+	fs.StringVar(&cfg.SummaryFile, "summary-file", "", "実行結果の要約 (状態、終了コード、件数、保存したファイル、所要時間、エラー) をJSONで書き出すファイル")
+	fs.BoolVar(&ff.dryRun, "dry-run", false, "最初のページのみリクエストし、取得計画（リクエストURL、見込みの件数・時間、書き込むファイル）を表示して終了")
+	registerStatsFlags(fs, cfg, &ff.stats)
+	registerNotifyFlags(fs, cfg, &ff.notify)
+}
+
+// fetchTarget 取得対象のゲーム（appID と name のどちらか一方を指定）
+type fetchTarget struct {
+	appID string
+	name  string
+}
+
+// String ログ表示用の文字列
+func (t fetchTarget) String() string {
+	if t.appID != "" {
+		return t.appID
+	}
+	return t.name
+}
+
+// fetchTargets 取得対象のゲームの一覧を返す
+//
+// -appid, -game が指定されていない場合は -games の一覧を使用し、数字のみの要素は App ID とみなす。
+func fetchTargets(cfg config.Config) []fetchTarget {
+	switch {
+	case cfg.AppID != "":
+		return []fetchTarget{{appID: cfg.AppID}}
+	case cfg.GameName != "":
+		return []fetchTarget{{name: cfg.GameName}}
+	}
+	var targets []fetchTarget
+	for _, game := range cfg.Games {
+		if isAppID(game) {
+			targets = append(targets, fetchTarget{appID: game})
+		} else {
+			targets = append(targets, fetchTarget{name: game})
+		}
+	}
+	return targets
+}
+
+// readGameList 1行に1つのApp IDまたはゲーム名を書いた一覧を読み込む（空行と # で始まる行は無視する）
+func readGameList(r io.Reader) ([]string, error) {
+	var games []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		games = append(games, line)
+	}
+	return games, scanner.Err()
+}
+
+// runFetch fetch コマンド: レビューを取得して保存し、統計を表示
+func runFetch(ctx context.Context, args []string) (err error) {
+	startedAt := time.Now()
+	var cfg config.Config
+	var ff fetchFlags
+
+	// コマンドライン引数の定義
+	fs := newFlagSet("fetch", &cfg)
+	registerFetchFlags(fs, &cfg, &ff)
+	fs.BoolVar(&ff.showVersion, "version", false, "バージョン情報を表示 (従来の指定方法との互換用)")
+
+	if err := parseFlags(fs, args, &cfg); err != nil {
+		return err
+	}
+
+	// バージョン情報の表示
+	if ff.showVersion {
+		fmt.Printf("%s\n", i18n.Tf(i18n.MsgAppVersion, config.Version))
+		return nil
+	}
+
+	// 実行結果の要約は、引数の誤りなどで取得を始めなかった場合も書き出す（ドライランでは何も書き込まない）
+	summary := newRunSummary(fs.Name(), startedAt)
+	if cfg.SummaryFile != "" && !ff.dryRun {
+		defer func() { err = summary.write(cfg.SummaryFile, err) }()
+	}
+
+	// 言語設定とゲームの一覧をパース
+	cfg.Languages = ParseLanguages(ff.languages)
+	cfg.Games = ParseLanguages(ff.games)
+	parseNotifyFlags(&cfg, ff.notify)
+
+	// 引数に "-" を指定した場合は、-games の代わりに標準入力からゲームの一覧を読み込む
+	if fs.NArg() > 0 {
+		if fs.NArg() > 1 || fs.Arg(0) != stdinArg {
+			return newUsageError(i18n.Tf(i18n.MsgErrorUnknownCommand, fs.Arg(fs.NArg()-1)))
+		}
+		if cfg.AppID != "" || cfg.GameName != "" {
+			return newUsageError(i18n.T(i18n.MsgErrorStdinConflict))
+		}
+		games, err := readGameList(os.Stdin)
+		if err != nil {
+			return errors.New(i18n.Tf(i18n.MsgErrorStdinRead, err))
+		}
+		cfg.Games = games
+	}
+
+	// バリデーション（通信を始める前にすべての設定値を検証）
+	if err := cfg.ValidateFetch(); err != nil {
+		return usageError{err: err}
+	}
+	cfg.Languages = config.NormalizeLanguages(cfg.Languages)
+	targets := fetchTargets(cfg)
+	if len(targets) == 0 {
+		return newUsageError(i18n.T(i18n.MsgErrorNoInput))
+	}
+
+	statsOpts, analyzer, err := statsOptions(&cfg, ff.stats)
+	st := fetchStats{opts: statsOpts, analyzer: analyzer}
+	if err != nil {
+		return err
+	}
+	notifier, err := newNotifier(cfg)
+	if err != nil {
+		return err
+	}
+
+	// ドライランではログファイルや出力ディレクトリも作成しない
+	if ff.dryRun {
+		return dryRunFetch(ctx, targets, cfg)
+	}
+
+	// ロガーを初期化
+	log, err := newLogger(cfg)
+	if err != nil {
+		return err
+	}
+	defer log.Close()
+	if notifier != nil {
+		notifier.SetLogger(log)
+	}
+	// 統計をJSON・CSVで標準出力に書き出す場合は、ログや保存したファイルの一覧が混ざらないように標準エラー出力に表示し、
+	// 複数のゲームでも1つのデータとして読み込めるように続けて書き込む
+	if cfg.StatsFile == "" && cfg.StatsFormat != config.StatsFormatText {
+		log.LogToStderr()
+		st.stdout = stats.NewStreamWriter(os.Stdout, cfg.StatsFormat)
+	}
+
+	// アプリケーション開始ログ
+	log.Info(i18n.Tf(i18n.MsgAppStarted, i18n.Tf(i18n.MsgAppVersion, config.Version)))
+
+	// 出力ディレクトリの作成
+	if cfg.OutputDir != "" {
+		if err := os.MkdirAll(cfg.OutputDir, 0755); err != nil {
+			return logError(log, newFailure(exitWrite, errors.New(i18n.Tf(i18n.MsgErrorDirCreation, err))))
+		}
+	}
+
+	// 複数のゲームを指定した場合は、失敗したゲームがあっても残りのゲームを取得する
+	var failedCodes []int
+	for _, target := range targets {
+		result := gameResult{Target: target.String()}
+		err := fetchGame(ctx, target, cfg, st, notifier, log, &result)
+		summary.add(result, err)
+		if err != nil {
+			// 中断された場合は残りのゲームを取得しない
+			var ie interruptedError
+			if len(targets) == 1 || errors.As(err, &ie) {
+				return logError(log, err)
+			}
+			var le loggedError
+			if !errors.As(err, &le) {
+				log.Error(i18n.Tf(i18n.MsgErrorCompareTarget, target.String(), err), "appid", result.AppID)
+			}
+			failedCodes = append(failedCodes, errorExitCode(err))
+		}
+	}
+	if len(failedCodes) > 0 {
+		return logError(log, fetchGamesError(failedCodes, len(targets)))
+	}
+
+	log.Info(i18n.T(i18n.MsgSuccessCompleted))
+	return nil
+}
+
+// fetchGamesError 複数のゲームのうち一部またはすべての取得に失敗した場合のエラー
+//
+// 一部のゲームのみ失敗した場合は exitPartial、すべて同じ理由で失敗した場合はその終了コードで終了する。
+func fetchGamesError(failedCodes []int, total int) error {
+	err := errors.New(i18n.Tf(i18n.MsgErrorFetchGames, len(failedCodes), total))
+	if len(failedCodes) < total {
+		return newFailure(exitPartial, err)
+	}
+	for _, code := range failedCodes[1:] {
+		if code != failedCodes[0] {
+			return err
+		}
+	}
+	return newFailure(failedCodes[0], err)
+}
+
+// newProgressBar レビュー取得の進捗表示を作成（-quiet の場合は nil）
+//
+// 標準エラー出力が端末の場合は進捗バーを表示し、それ以外の場合（および -verbose の場合）は定期的にログに記録する。
+func newProgressBar(cfg config.Config, log *logger.Logger) *progress.Bar {
+	if cfg.Quiet {
+		return nil
+	}
+	tty := term.IsTerminal(os.Stderr) && !cfg.Verbose
+	return progress.New(os.Stderr, tty, term.Width(os.Stderr), func(line string) { log.Info(line) })
+}
+
+// fetchTargetReviews 取得対象のゲームのレビューを進捗を表示しながら取得し、App ID とともに返す
+//
+// ctx がキャンセルされた場合は、それまでに取得したレビューと ctx.Err() を返す。
+func fetchTargetReviews(ctx context.Context, target fetchTarget, cfg config.Config, log *logger.Logger) ([]models.ReviewData, string, error) {
+	appID := target.appID
+	if appID == "" {
+		log.Debug(i18n.Tf(i18n.MsgVerboseAppIDLookup, target.name))
+		var err error
+		if appID, err = resolveAppID(ctx, target.name); err != nil {
+			return nil, "", err
+		}
+		log.Debug(i18n.Tf(i18n.MsgVerboseGameReviewFetch, target.name, appID), "appid", appID)
+	}
+
+	var onProgress api.ProgressFunc
+	bar := newProgressBar(cfg, log)
+	if bar != nil {
+		onProgress = bar.Update
+	}
+	reviews, err := api.FetchAllReviewsContext(ctx, appID, cfg.MaxReviews, cfg.Languages, cfg.Filter, log, onProgress)
+	bar.Finish()
+	if err != nil {
+		return reviews, appID, newFailure(exitNetwork, err)
+	}
+	return reviews, appID, nil
+}
+
+// apiFailure Steam API のエラーを、ゲームが存在しない場合は exitNotFound、それ以外は exitNetwork で終了するエラーにする
+func apiFailure(err error) error {
+	if errors.Is(err, api.ErrGameNotFound) {
+		return newFailure(exitNotFound, err)
+	}
+	return newFailure(exitNetwork, err)
+}
+
+// resolveAppID ゲーム名から App ID を取得（失敗した場合は apiFailure のエラーを返す）
+func resolveAppID(ctx context.Context, name string) (string, error) {
+	appID, err := api.GetAppIDByNameContext(ctx, name)
+	if err != nil {
+		return "", apiFailure(fmt.Errorf(i18n.T(i18n.MsgErrorAppIDFetch), err))
+	}
+	return appID, nil
+}
+
+// reviewsBaseFilename 取得したレビューを保存するファイル名（出力ディレクトリを含まない）
+func reviewsBaseFilename(appID string, cfg config.Config) string {
+	ext := config.FileExtTXT
+	if cfg.OutputJSON {
+		ext = config.FileExtJSON
+	}
+	return fmt.Sprintf("steam_reviews_%s%s", appID, ext)
+}
+
+// unansweredFilename 未返信の否定的レビューを保存するファイル名
+func unansweredFilename(appID string, cfg config.Config) string {
+	ext := config.FileExtTXT
+	if cfg.OutputJSON {
+		ext = config.FileExtJSON
+	}
+	filename := fmt.Sprintf("steam_reviews_%s_unanswered_negative%s", appID, ext)
+	if cfg.OutputDir != "" {
+		filename = cfg.OutputDir + "/" + filename
+	}
+	return filename
+}
+
+// keywordsFilename キーワード分析の結果を保存するCSVファイル名
+func keywordsFilename(appID string, cfg config.Config) string {
+	filename := fmt.Sprintf("steam_reviews_%s_keywords.csv", appID)
+	if cfg.OutputDir != "" {
+		filename = cfg.OutputDir + "/" + filename
+	}
+	return filename
+}
+
+// statsFilename fetch が統計を書き込むファイル名
+//
+// 複数のゲームを取得する場合は、上書きしないように拡張子の前に "_<App ID>" を付ける
+// (例: stats.json → stats_440.json)。
+func statsFilename(appID string, cfg config.Config) string {
+	if cfg.StatsFile == "" || len(fetchTargets(cfg)) <= 1 {
+		return cfg.StatsFile
+	}
+	ext := filepath.Ext(cfg.StatsFile)
+	return strings.TrimSuffix(cfg.StatsFile, ext) + "_" + appID + ext
+}
+
+// savePartialReviews 途中までに取得したレビューを不完全であることを明記して保存
+//
+// cause が nil の場合は中断として interruptedError を返し、そうでなければ cause の終了コードを引き継いだエラーを返す。
+func savePartialReviews(reviews []models.ReviewData, appID string, cfg config.Config, cause error, result *gameResult) error {
+	reason := models.IncompleteInterrupted
+	if cause != nil {
+		reason = models.IncompleteNetworkError
+	}
+	metadata := &models.FetchMetadata{Incomplete: true, Reason: reason, FetchedAt: time.Now()}
+	baseFilename := reviewsBaseFilename(appID, cfg)
+
+	var savedFiles []string
+	var err error
+	if cfg.SplitByLang {
+		savedFiles, err = storage.SaveReviewsByLanguageWithMetadata(reviews, baseFilename, cfg.OutputDir, nil, cfg.OutputJSON, nil, metadata)
+	} else {
+		var savedFile string
+		savedFile, err = storage.SaveReviewsToFileWithMetadata(reviews, filepath.Join(cfg.OutputDir, baseFilename), cfg.OutputJSON, nil, metadata)
+		savedFiles = append(savedFiles, savedFile)
+	}
+	if err != nil {
+		err = errors.New(i18n.Tf(i18n.MsgErrorFileSave, err))
+		if cause != nil {
+			return errors.Join(cause, newFailure(exitWrite, err))
+		}
+		return interruptedError{err: err}
+	}
+	result.Incomplete = true
+	result.Files = savedFiles
+	if cause != nil {
+		return wrapFailure(cause, i18n.Tf(i18n.MsgFetchFailedSaved, cause, len(reviews), strings.Join(savedFiles, ", ")))
+	}
+	return interruptedError{err: errors.New(i18n.Tf(i18n.MsgFetchInterruptedSaved, len(reviews), strings.Join(savedFiles, ", ")))}
+}
+
+// fetchGame 1つのゲームのレビューを取得して保存し、統計を表示（取得結果を result に記録する）
+//
+// 取得中に ctx がキャンセルされた場合は、取得済みのレビューを不完全として保存して interruptedError を返す。
+// 取得途中に通信エラーになった場合も、取得済みのレビューを不完全として保存してエラーを返す。
+// ファイルや統計の書き込みに失敗した場合は、残りのファイルの保存と統計の表示を続け、
+// 最後に exitWrite で終了するエラー（ログに出力済み）を返す。
+func fetchGame(ctx context.Context, target fetchTarget, cfg config.Config, st fetchStats, notifier *notify.Notifier, log *logger.Logger, result *gameResult) error {
+	// レビュー取得
+	start := time.Now()
+	reviews, appID, err := fetchTargetReviews(ctx, target, cfg, log)
+	result.AppID = appID
+	result.Reviews = len(reviews)
+	if ctx.Err() != nil {
+		if len(reviews) == 0 {
+			return interruptedError{err: errors.New(i18n.T(i18n.MsgFetchInterrupted))}
+		}
+		return savePartialReviews(reviews, appID, cfg, nil, result)
+	}
+	if err != nil {
+		// 通信エラーでも、それまでに取得したレビューは不完全として保存する
+		if len(reviews) > 0 {
+			return savePartialReviews(reviews, appID, cfg, err, result)
+		}
+		return err
+	}
+
+	if len(reviews) == 0 {
+		log.Info(i18n.T(i18n.MsgStatsNoReviews), "appid", appID)
+		return nil
+	}
+
+	log.Info(i18n.Tf(i18n.MsgFetchReviewsFetched, len(reviews)), "appid", appID, "reviews", len(reviews), "duration", time.Since(start))
+
+	// 感情スコアを計算
+	if st.analyzer != nil {
+		annotateSentiment(reviews, st.analyzer, log)
+	}
+
+	// ゲーム詳細情報を取得
+	gameDetails, err := api.GetGameDetailsContext(ctx, appID, log)
+	if err != nil {
+		log.Warn(i18n.Tf(i18n.MsgErrorGameDetailsInit, err), "appid", appID)
+		// ゲーム詳細情報が取得できなくてもレビュー保存は続行
+		gameDetails = nil
+	}
+
+	// ゲーム情報を使用して統計情報を計算
+	displayGameName := target.name
+	if target.appID != "" {
+		displayGameName = fmt.Sprintf("App ID %s", appID)
+	}
+	if gameDetails != nil {
+		displayGameName = gameDetails.Name
+		result.Name = gameDetails.Name
+	}
+	reviewStats := stats.Compute(reviews, displayGameName, st.opts)
+
+	// 前回保存したJSONファイルにないレビューを通知（上書きする前に比較する。前回のファイルがなければ通知しない）
+	if notifier != nil {
+		previous, err := loadWatchGame(appID, cfg.OutputDir)
+		switch {
+		case err != nil:
+			log.Warn(err.Error(), "appid", appID)
+		case !previous.saved:
+			log.Info(i18n.Tf(i18n.MsgNotifyNoBaseline, previous.filename), "appid", appID)
+		default:
+			sendNotifications(ctx, notifier, appID, gameDetails, previous.merge(reviews), log)
+		}
+	}
+
+	// 書き込みの失敗はログに出力して記録し、残りの保存を続ける
+	var writeErrs []error
+	writeFailed := func(err error) {
+		log.Error(err.Error(), "appid", appID)
+		writeErrs = append(writeErrs, err)
+	}
+
+	// ファイル保存
+	baseFilename := reviewsBaseFilename(appID, cfg)
+
+	if cfg.SplitByLang {
+		files, err := storage.SaveReviewsByLanguageWithGameDetails(reviews, baseFilename, cfg.OutputDir, log, cfg.OutputJSON, gameDetails)
+		if err != nil {
+			writeFailed(errors.New(i18n.Tf(i18n.MsgErrorFileSave, err)))
+		}
+		result.Files = files
+	} else {
+		filename := baseFilename
+		if cfg.OutputDir != "" {
+			filename = cfg.OutputDir + "/" + filename
+		}
+
+		if savedFile, err := storage.SaveReviewsToFileWithGameDetails(reviews, filename, cfg.OutputJSON, gameDetails); err != nil {
+			writeFailed(errors.New(i18n.Tf(i18n.MsgErrorFileSave, err)))
+		} else {
+			result.Files = append(result.Files, savedFile)
+			log.Debug(i18n.Tf(i18n.MsgVerboseReviewSaved, filename))
+		}
+	}
+
+	// 未返信の否定的レビューを保存
+	if cfg.ExportUnanswered {
+		unanswered := stats.UnansweredNegativeReviews(reviews)
+		filename := unansweredFilename(appID, cfg)
+		if savedFile, err := storage.SaveReviewsToFileWithGameDetails(unanswered, filename, cfg.OutputJSON, gameDetails); err != nil {
+			writeFailed(errors.New(i18n.Tf(i18n.MsgErrorFileSave, err)))
+		} else {
+			result.Files = append(result.Files, savedFile)
+			log.Debug(i18n.Tf(i18n.MsgFileUnansweredSaved, savedFile, len(unanswered)))
+		}
+	}
+
+	// キーワード分析の結果をCSVで保存
+	if cfg.Keywords {
+		filename := keywordsFilename(appID, cfg)
+		if err := saveKeywordsCSV(*reviewStats.Keywords, filename); err != nil {
+			writeFailed(errors.New(i18n.Tf(i18n.MsgErrorFileSave, err)))
+		} else {
+			result.Files = append(result.Files, filename)
+		}
+	}
+
+	// 保存したファイル一覧を表示（端末のみ）
+	log.Printf("\n%s\n", i18n.T(i18n.MsgFileSavedFiles))
+	for _, file := range result.Files {
+		log.Printf("- %s\n", file)
+	}
+	log.Println()
+
+	// 統計情報を出力
+	gameCfg := cfg
+	gameCfg.StatsFile = statsFilename(appID, cfg)
+	if st.stdout != nil {
+		err = st.stdout.Write(reviewStats)
+	} else {
+		err = writeStats(reviewStats, gameCfg, log)
+	}
+	if err != nil {
+		writeFailed(errors.New(i18n.Tf(i18n.MsgErrorStatsWrite, err)))
+	} else if gameCfg.StatsFile != "" {
+		result.Files = append(result.Files, gameCfg.StatsFile)
+	}
+
+	if len(writeErrs) > 0 {
+		return loggedError{err: newFailure(exitWrite, errors.Join(writeErrs...))}
+	}
+	return nil
+}
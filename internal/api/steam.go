@@ -1,27 +1,46 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/y-moriya/steam-review/internal/logger"
 	"github.com/y-moriya/steam-review/internal/models"
 	"github.com/y-moriya/steam-review/pkg/config"
 	"github.com/y-moriya/steam-review/pkg/i18n"
 )
 
-// GetAppIDByName ゲーム名からSteam App IDを取得
-func GetAppIDByName(gameName string) (string, error) {
+const (
+	// FirstCursor 最初のページを取得するためのカーソル
+	FirstCursor = "*"
+	// ReviewsPerPage 1回のリクエストで取得するレビュー数（Steam APIの上限）
+	ReviewsPerPage = 100
+	// RequestInterval レート制限対策としてページの取得の間に待つ時間
+	RequestInterval = 1 * time.Second
+)
+
+// httpGet ctx をキャンセルすると中断される GET リクエストを送信
+func httpGet(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	return http.DefaultClient.Do(req)
+}
+
+// fetchAppList Steamの全アプリ一覧を取得
+func fetchAppList(ctx context.Context) ([]models.AppListEntry, error) {
 	url := "https://api.steampowered.com/ISteamApps/GetAppList/v2/"
-	resp, err := http.Get(url)
+	resp, err := httpGet(ctx, url)
 	if err != nil {
-		return "", errors.New(i18n.Tf(i18n.MsgErrorSteamAPIFetch, err))
+		return nil, fmt.Errorf(i18n.T(i18n.MsgErrorSteamAPIFetch), err)
 	}
 	defer resp.Body.Close()
 
@@ -35,15 +54,108 @@ func GetAppIDByName(gameName string) (string, error) {
 	}
 
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", errors.New(i18n.Tf(i18n.MsgErrorJSONDecode, err))
+		return nil, fmt.Errorf(i18n.T(i18n.MsgErrorJSONDecode), err)
 	}
 
+	apps := make([]models.AppListEntry, 0, len(result.Applist.Apps))
 	for _, app := range result.Applist.Apps {
+		apps = append(apps, models.AppListEntry{AppID: fmt.Sprintf("%d", app.AppID), Name: app.Name})
+	}
+	return apps, nil
+}
+
+// GetAppListContext Steamの全アプリ一覧を取得
+func GetAppListContext(ctx context.Context) ([]models.AppListEntry, error) {
+	return fetchAppList(ctx)
+}
+
+// ErrGameNotFound 指定したゲームが存在しない（errors.Is で判定する）
+var ErrGameNotFound = errors.New("game not found")
+
+// gameNotFoundError 名前やApp IDに一致するゲームがない場合のエラー
+type gameNotFoundError struct {
+	msg string
+}
+
+func (e gameNotFoundError) Error() string {
+	return e.msg
+}
+
+func (e gameNotFoundError) Is(target error) bool {
+	return target == ErrGameNotFound
+}
+
+// GetAppIDByName ゲーム名からSteam App IDを取得
+func GetAppIDByName(gameName string) (string, error) {
+	return GetAppIDByNameContext(context.Background(), gameName)
+}
+
+// GetAppIDByNameContext GetAppIDByName の ctx でキャンセルできる版
+func GetAppIDByNameContext(ctx context.Context, gameName string) (string, error) {
+	apps, err := fetchAppList(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, app := range apps {
 		if strings.EqualFold(app.Name, gameName) {
-			return fmt.Sprintf("%d", app.AppID), nil
+			return app.AppID, nil
 		}
 	}
-	return "", errors.New(i18n.Tf(i18n.MsgErrorGameNotFound, gameName))
+	return "", gameNotFoundError{msg: i18n.Tf(i18n.MsgErrorGameNotFound, gameName)}
+}
+
+// SearchApps 名前に検索語を含むアプリを検索（大文字小文字は区別しない）
+//
+// 完全一致、前方一致、部分一致の順に、同じ順位の中では名前の短い順に最大limit件を返す（0以下は無制限）。
+func SearchApps(query string, limit int) ([]models.AppListEntry, error) {
+	return SearchAppsContext(context.Background(), query, limit)
+}
+
+// SearchAppsContext SearchApps の ctx でキャンセルできる版
+func SearchAppsContext(ctx context.Context, query string, limit int) ([]models.AppListEntry, error) {
+	apps, err := fetchAppList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return MatchApps(apps, query, limit), nil
+}
+
+// MatchApps アプリ一覧から名前に検索語を含むものを SearchApps と同じ順序で返す
+func MatchApps(apps []models.AppListEntry, query string, limit int) []models.AppListEntry {
+	query = strings.ToLower(strings.TrimSpace(query))
+	rank := func(name string) int {
+		name = strings.ToLower(name)
+		switch {
+		case name == query:
+			return 0
+		case strings.HasPrefix(name, query):
+			return 1
+		case strings.Contains(name, query):
+			return 2
+		default:
+			return -1
+		}
+	}
+
+	var matches []models.AppListEntry
+	for _, app := range apps {
+		if rank(app.Name) >= 0 {
+			matches = append(matches, app)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		ri, rj := rank(matches[i].Name), rank(matches[j].Name)
+		if ri != rj {
+			return ri < rj
+		}
+		return len(matches[i].Name) < len(matches[j].Name)
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
 }
 
 // setLanguageFilter Steam APIのリクエストパラメータに言語フィルタを設定
@@ -80,6 +192,11 @@ func setFilter(params url.Values, filter string) {
 
 // FetchReviewsFromSteam Steam APIから直接レビューを取得
 func FetchReviewsFromSteam(appID string, cursor string, numPerPage int, filter string, languages []string) (*models.SteamReviewResponse, error) {
+	return FetchReviewsFromSteamContext(context.Background(), appID, cursor, numPerPage, filter, languages)
+}
+
+// ReviewsURL FetchReviewsFromSteam が送信するリクエストのURL
+func ReviewsURL(appID string, cursor string, numPerPage int, filter string, languages []string) string {
 	baseURL := "https://store.steampowered.com/appreviews/" + appID
 
 	params := url.Values{}
@@ -92,11 +209,14 @@ func FetchReviewsFromSteam(appID string, cursor string, numPerPage int, filter s
 	setLanguageFilter(params, languages)
 	setFilter(params, filter)
 
-	fullURL := baseURL + "?" + params.Encode()
+	return baseURL + "?" + params.Encode()
+}
 
-	resp, err := http.Get(fullURL)
+// FetchReviewsFromSteamContext FetchReviewsFromSteam の ctx でキャンセルできる版
+func FetchReviewsFromSteamContext(ctx context.Context, appID string, cursor string, numPerPage int, filter string, languages []string) (*models.SteamReviewResponse, error) {
+	resp, err := httpGet(ctx, ReviewsURL(appID, cursor, numPerPage, filter, languages))
 	if err != nil {
-		return nil, errors.New(i18n.Tf(i18n.MsgErrorHTTPRequest, err))
+		return nil, fmt.Errorf(i18n.T(i18n.MsgErrorHTTPRequest), err)
 	}
 	defer resp.Body.Close()
 
@@ -106,7 +226,7 @@ func FetchReviewsFromSteam(appID string, cursor string, numPerPage int, filter s
 
 	var result models.SteamReviewResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, errors.New(i18n.Tf(i18n.MsgErrorJSONDecode, err))
+		return nil, fmt.Errorf(i18n.T(i18n.MsgErrorJSONDecode), err)
 	}
 
 	if result.Success != 1 {
@@ -116,6 +236,20 @@ func FetchReviewsFromSteam(appID string, cursor string, numPerPage int, filter s
 	return &result, nil
 }
 
+// GetReviewSummary 指定されたApp IDのレビュー集計情報（review_score_descなど）を取得
+func GetReviewSummary(appID string, languages []string, filter string) (*models.QuerySummary, error) {
+	return GetReviewSummaryContext(context.Background(), appID, languages, filter)
+}
+
+// GetReviewSummaryContext GetReviewSummary の ctx でキャンセルできる版
+func GetReviewSummaryContext(ctx context.Context, appID string, languages []string, filter string) (*models.QuerySummary, error) {
+	resp, err := FetchReviewsFromSteamContext(ctx, appID, FirstCursor, 1, filter, languages)
+	if err != nil {
+		return nil, err
+	}
+	return &resp.QuerySummary, nil
+}
+
 // FilterReviewsByLanguage 指定された言語のレビューのみをフィルタ
 func FilterReviewsByLanguage(reviews []models.ReviewData, languages []string) []models.ReviewData {
 	if len(languages) == 0 {
@@ -144,11 +278,63 @@ func FilterReviewsByLanguage(reviews []models.ReviewData, languages []string) []
 	return filtered
 }
 
+// Logger ページごとの取得件数や所要時間を記録する詳細ログ（nil の場合は記録しない）
+//
+// 属性には appid, cursor, page, duration などのキーを使う。
+type Logger interface {
+	Debug(msg string, args ...any)
+}
+
+// debug logger が nil でなければ詳細ログを記録
+func debug(logger Logger, msg string, args ...any) {
+	if logger != nil {
+		logger.Debug(msg, args...)
+	}
+}
+
+// ProgressFunc レビュー取得の進捗を受け取る関数
+//
+// fetched は取得済みのレビュー数、pages は取得済みのページ数、total は最初のページの集計情報 (total_reviews)
+// から見込んだ取得件数（maxReviews で制限。不明な場合は0）。
+type ProgressFunc func(fetched, pages, total int)
+
 // FetchAllReviews 指定されたApp IDのレビューを取得
-func FetchAllReviews(appID string, maxReviews int, verbose bool, languages []string, filter string, logger *logger.Logger) ([]models.ReviewData, error) {
+func FetchAllReviews(appID string, maxReviews int, languages []string, filter string, logger Logger) ([]models.ReviewData, error) {
+	return FetchAllReviewsContext(context.Background(), appID, maxReviews, languages, filter, logger, nil)
+}
+
+// FetchAllReviewsContext FetchAllReviews の ctx でキャンセルできる版（progress が nil でなければページごとに進捗を通知）
+//
+// ctx がキャンセルされた場合は、それまでに取得したレビューと ctx.Err() を返す。
+func FetchAllReviewsContext(ctx context.Context, appID string, maxReviews int, languages []string, filter string, logger Logger, progress ProgressFunc) ([]models.ReviewData, error) {
+	return fetchReviews(ctx, appID, maxReviews, languages, filter, logger, nil, progress)
+}
+
+// FetchNewReviews 作成日時の新しい順にレビューを取得し、既知のレビューに達した時点で終了
+//
+// known には取得済みのレビューID (RecommendationID) を渡す。既知のレビューより古いレビューは取得しない。
+// maxReviews は既知のレビューに達しない場合（初回など）の上限 (0で無制限)。
+func FetchNewReviews(appID string, known map[string]bool, maxReviews int, languages []string, logger Logger) ([]models.ReviewData, error) {
+	return FetchNewReviewsContext(context.Background(), appID, known, maxReviews, languages, logger)
+}
+
+// FetchNewReviewsContext FetchNewReviews の ctx でキャンセルできる版
+//
+// ctx がキャンセルされた場合は、それまでに取得したレビューと ctx.Err() を返す。
+func FetchNewReviewsContext(ctx context.Context, appID string, known map[string]bool, maxReviews int, languages []string, logger Logger) ([]models.ReviewData, error) {
+	return fetchReviews(ctx, appID, maxReviews, languages, config.FilterRecent, logger, func(review models.ReviewData) bool {
+		return known[review.RecommendationID]
+	}, nil)
+}
+
+// fetchReviews ページを順に取得してレビューを集める（stop が true を返したレビューの手前で終了）
+func fetchReviews(ctx context.Context, appID string, maxReviews int, languages []string, filter string, logger Logger, stop func(models.ReviewData) bool, progress ProgressFunc) ([]models.ReviewData, error) {
 	var allReviews []models.ReviewData
-	cursor := "*"
-	numPerPage := 100
+	start := time.Now()
+	cursor := FirstCursor
+	numPerPage := ReviewsPerPage
+	pages := 0
+	expected := 0
 
 	// 言語フィルタの準備
 	langSet := make(map[string]bool)
@@ -163,24 +349,33 @@ func FetchAllReviews(appID string, maxReviews int, verbose bool, languages []str
 		}
 	}
 
-	if verbose && logger != nil {
-		logger.Verbose(i18n.Tf(i18n.MsgVerboseReviewFetchStart, appID))
-	}
+	debug(logger, i18n.Tf(i18n.MsgVerboseReviewFetchStart, appID), "appid", appID, "filter", filter)
 
 	for {
-		if verbose && logger != nil {
-			logger.Verbose(i18n.Tf(i18n.MsgVerboseReviewProgress, len(allReviews), cursor))
-		}
+		debug(logger, i18n.Tf(i18n.MsgVerboseReviewProgress, len(allReviews), cursor), "appid", appID, "page", pages+1, "cursor", cursor)
 
-		resp, err := FetchReviewsFromSteam(appID, cursor, numPerPage, filter, languages)
+		requested := time.Now()
+		resp, err := FetchReviewsFromSteamContext(ctx, appID, cursor, numPerPage, filter, languages)
+		if ctx.Err() != nil {
+			return allReviews, ctx.Err()
+		}
 		if err != nil {
-			return nil, errors.New(i18n.Tf(i18n.MsgErrorReviewFetch, err))
+			return allReviews, errors.New(i18n.Tf(i18n.MsgErrorReviewFetch, err))
 		}
 
-		if len(resp.Reviews) == 0 {
-			if verbose && logger != nil {
-				logger.Verbose(i18n.T(i18n.MsgVerboseNoMoreReviews))
+		pages++
+		debug(logger, i18n.Tf(i18n.MsgVerbosePageFetched, pages, len(resp.Reviews)),
+			"appid", appID, "page", pages, "cursor", cursor, "duration", time.Since(requested))
+		if cursor == FirstCursor {
+			// 集計情報は最初のページのみ含まれる
+			expected = resp.QuerySummary.TotalReviews
+			if maxReviews > 0 && (expected == 0 || expected > maxReviews) {
+				expected = maxReviews
 			}
+		}
+
+		if len(resp.Reviews) == 0 {
+			debug(logger, i18n.T(i18n.MsgVerboseNoMoreReviews), "appid", appID, "page", pages)
 			break
 		}
 
@@ -191,83 +386,99 @@ func FetchAllReviews(appID string, maxReviews int, verbose bool, languages []str
 			}
 
 			rd := models.ConvertSteamReview(sr)
+			if stop != nil && stop(rd) {
+				debug(logger, i18n.Tf(i18n.MsgVerboseKnownReviewReached, rd.RecommendationID), "appid", appID, "page", pages)
+				return allReviews, nil
+			}
 			allReviews = append(allReviews, rd)
 
 			if maxReviews > 0 && len(allReviews) >= maxReviews {
-				if verbose && logger != nil {
-					logger.Verbose(i18n.Tf(i18n.MsgVerboseMaxReviewsReached, maxReviews))
+				debug(logger, i18n.Tf(i18n.MsgVerboseMaxReviewsReached, maxReviews), "appid", appID, "page", pages)
+				if progress != nil {
+					progress(maxReviews, pages, expected)
 				}
 				return allReviews[:maxReviews], nil
 			}
 		}
 
+		if progress != nil {
+			progress(len(allReviews), pages, expected)
+		}
+
 		if resp.Cursor == cursor || resp.Cursor == "" {
-			if verbose && logger != nil {
-				logger.Verbose(i18n.T(i18n.MsgVerboseCursorNotChanged))
-			}
+			debug(logger, i18n.T(i18n.MsgVerboseCursorNotChanged), "appid", appID, "page", pages, "cursor", cursor)
 			break
 		}
 
 		cursor = resp.Cursor
 
 		// レート制限対策
-		time.Sleep(1 * time.Second)
+		select {
+		case <-ctx.Done():
+			return allReviews, ctx.Err()
+		case <-time.After(RequestInterval):
+		}
 	}
 
-	if verbose && logger != nil {
-		logger.Verbose(i18n.Tf(i18n.MsgVerboseTotalReviewsFetched, len(allReviews)))
-	}
+	debug(logger, i18n.Tf(i18n.MsgVerboseTotalReviewsFetched, len(allReviews)), "appid", appID, "page", pages, "duration", time.Since(start))
 	return allReviews, nil
 }
 
 // GetReviewsByGameName ゲーム名からレビューを取得
-func GetReviewsByGameName(gameName string, maxReviews int, verbose bool, languages []string, filter string, logger *logger.Logger) ([]models.ReviewData, string, error) {
-	appID, err := GetAppIDByName(gameName)
+func GetReviewsByGameName(gameName string, maxReviews int, languages []string, filter string, logger Logger) ([]models.ReviewData, string, error) {
+	return GetReviewsByGameNameContext(context.Background(), gameName, maxReviews, languages, filter, logger)
+}
+
+// GetReviewsByGameNameContext GetReviewsByGameName の ctx でキャンセルできる版
+//
+// レビューの取得中に ctx がキャンセルされた場合は、それまでに取得したレビューと ctx.Err() を返す。
+func GetReviewsByGameNameContext(ctx context.Context, gameName string, maxReviews int, languages []string, filter string, logger Logger) ([]models.ReviewData, string, error) {
+	appID, err := GetAppIDByNameContext(ctx, gameName)
 	if err != nil {
-		return nil, "", errors.New(i18n.Tf(i18n.MsgErrorAppIDFetch, err))
+		return nil, "", fmt.Errorf(i18n.T(i18n.MsgErrorAppIDFetch), err)
 	}
-	if verbose && logger != nil {
-		logger.Verbose(i18n.Tf(i18n.MsgVerboseGameReviewFetch, gameName, appID))
-	}
-	reviews, err := FetchAllReviews(appID, maxReviews, verbose, languages, filter, logger)
+	debug(logger, i18n.Tf(i18n.MsgVerboseGameReviewFetch, gameName, appID), "appid", appID)
+	reviews, err := FetchAllReviewsContext(ctx, appID, maxReviews, languages, filter, logger, nil)
 	return reviews, appID, err
 }
 
 // GetGameDetails Steam Store APIからゲーム詳細情報を取得
-func GetGameDetails(appID string, verbose bool, logger *logger.Logger) (*models.GameDetails, error) {
-	if verbose && logger != nil {
-		logger.Verbose(i18n.Tf(i18n.MsgVerboseGameDetailsFetch, appID))
-	}
+func GetGameDetails(appID string, logger Logger) (*models.GameDetails, error) {
+	return GetGameDetailsContext(context.Background(), appID, logger)
+}
+
+// GetGameDetailsContext GetGameDetails の ctx でキャンセルできる版
+func GetGameDetailsContext(ctx context.Context, appID string, logger Logger) (*models.GameDetails, error) {
+	debug(logger, i18n.Tf(i18n.MsgVerboseGameDetailsFetch, appID), "appid", appID)
+	start := time.Now()
 
 	url := fmt.Sprintf("https://store.steampowered.com/api/appdetails?appids=%s&l=japanese", appID)
-	resp, err := http.Get(url)
+	resp, err := httpGet(ctx, url)
 	if err != nil {
-		return nil, errors.New(i18n.Tf(i18n.MsgErrorSteamStoreFetch, err))
+		return nil, fmt.Errorf(i18n.T(i18n.MsgErrorSteamStoreFetch), err)
 	}
 	defer resp.Body.Close()
 
 	// レスポンスを一度マップとして読み込む
 	var responseMap map[string]models.SteamAppDetailsResponse
 	if err := json.NewDecoder(resp.Body).Decode(&responseMap); err != nil {
-		return nil, errors.New(i18n.Tf(i18n.MsgErrorJSONDecode, err))
+		return nil, fmt.Errorf(i18n.T(i18n.MsgErrorJSONDecode), err)
 	}
 
 	// App IDに対応するデータを取得
 	appResponse, exists := responseMap[appID]
 	if !exists {
-		return nil, errors.New(i18n.Tf(i18n.MsgErrorAppDataNotFound, appID))
+		return nil, gameNotFoundError{msg: i18n.Tf(i18n.MsgErrorAppDataNotFound, appID)}
 	}
 
 	if !appResponse.Success {
-		return nil, errors.New(i18n.Tf(i18n.MsgErrorGameDetailsFail, appID))
+		return nil, gameNotFoundError{msg: i18n.Tf(i18n.MsgErrorGameDetailsFail, appID)}
 	}
 
 	// GameDetailsに変換
 	gameDetails := models.ConvertToGameDetails(appID, appResponse)
 
-	if verbose && logger != nil {
-		logger.Verbose(i18n.Tf(i18n.MsgVerboseGameDetailsObtained, gameDetails.Name))
-	}
+	debug(logger, i18n.Tf(i18n.MsgVerboseGameDetailsObtained, gameDetails.Name), "appid", appID, "duration", time.Since(start))
 
 	return &gameDetails, nil
 }
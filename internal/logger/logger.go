@@ -1,24 +1,63 @@
 package logger
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
-// Logger カスタムロガー
+// Options ロガーの設定
+type Options struct {
+	Level slog.Level // 出力する最低のレベル
+	JSON  bool       // 端末とログファイルに slog.JSONHandler の形式で出力する（false の場合は端末に人が読む形式、ログファイルに slog.TextHandler の形式）
+}
+
+// ParseLevel レベル名 (debug, info, warn, error。大文字小文字は区別しない) をレベルに変換
+func ParseLevel(name string) (slog.Level, bool) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return slog.LevelDebug, true
+	case "info":
+		return slog.LevelInfo, true
+	case "warn":
+		return slog.LevelWarn, true
+	case "error":
+		return slog.LevelError, true
+	}
+	return 0, false
+}
+
+// Logger log/slog によるレベル付きの構造化ロガー
+//
+// 詳細・情報ログは標準出力、警告・エラーログは標準エラー出力に表示し、すべてをログファイルにも記録する。
+// Print 系のメソッドはログではなく、保存したファイルの一覧などの補足的な表示を端末のみに書き出す
+// （詳細・情報ログと同じく標準出力、LogToStderr の後は標準エラー出力）。
+// コマンドの結果（統計など）は quiet でも表示するため、Logger を介さずに標準出力に書き出す。
 type Logger struct {
-	infoLogger  *log.Logger
-	errorLogger *log.Logger
-	file        *os.File
-	verbose     bool
+	*slog.Logger
+	console *console
+	closer  io.Closer
+}
+
+// console 端末への表示の設定（Logger と、With などで作成したハンドラーで共有する）
+type console struct {
+	mu     sync.Mutex
+	stdout io.Writer // 詳細・情報ログの表示先
+	stderr io.Writer // 警告・エラーログの表示先
+	info   io.Writer // 詳細・情報ログの現在の表示先（stdout または stderr）
+	quiet  bool
 }
 
-// New 新しいロガーを作成
-func New(logDir string, verbose bool) (*Logger, error) {
+// New logDir に実行日時のログファイルを作成し、新しいロガーを作成
+func New(logDir string, opts Options) (*Logger, error) {
 	// ログディレクトリを作成
 	if err := os.MkdirAll(logDir, 0755); err != nil {
 		return nil, fmt.Errorf("ログディレクトリの作成に失敗しました: %v", err)
@@ -34,85 +73,226 @@ func New(logDir string, verbose bool) (*Logger, error) {
 		return nil, fmt.Errorf("ログファイルのオープンに失敗しました: %v", err)
 	}
 
-	// マルチライター（標準出力とファイルの両方に出力）
-	multiWriter := io.MultiWriter(os.Stdout, file)
-	multiErrorWriter := io.MultiWriter(os.Stderr, file)
+	l := NewWriter(file, os.Stdout, os.Stderr, opts)
+	l.closer = file
+	return l, nil
+}
 
-	// ロガーを作成
-	infoLogger := log.New(multiWriter, "[INFO] ", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile)
-	errorLogger := log.New(multiErrorWriter, "[ERROR] ", log.Ldate|log.Ltime|log.Lmicroseconds|log.Lshortfile)
+// NewWriter ログファイルの代わりに file に記録し、stdout・stderr に表示するロガーを作成（Close は何もしない）
+func NewWriter(file, stdout, stderr io.Writer, opts Options) *Logger {
+	c := &console{stdout: stdout, stderr: stderr, info: stdout}
+	level := opts.Level
+	handlerOpts := &slog.HandlerOptions{Level: level}
 
-	return &Logger{
-		infoLogger:  infoLogger,
-		errorLogger: errorLogger,
-		file:        file,
-		verbose:     verbose,
-	}, nil
+	var fileHandler, infoHandler, warnHandler slog.Handler
+	if opts.JSON {
+		fileHandler = slog.NewJSONHandler(file, handlerOpts)
+		infoHandler = slog.NewJSONHandler(consoleWriter{c, false}, handlerOpts)
+		warnHandler = slog.NewJSONHandler(consoleWriter{c, true}, handlerOpts)
+	} else {
+		fileHandler = slog.NewTextHandler(file, handlerOpts)
+		infoHandler = &textHandler{w: consoleWriter{c, false}}
+		warnHandler = &textHandler{w: consoleWriter{c, true}}
+	}
+
+	handler := fanoutHandler{
+		fileHandler,
+		&consoleHandler{console: c, level: level, info: infoHandler, warn: warnHandler},
+	}
+	return &Logger{Logger: slog.New(handler), console: c}
 }
 
-// Info 情報ログを出力
-func (l *Logger) Info(v ...interface{}) {
-	l.infoLogger.Println(v...)
+// SetQuiet エラー以外のログと Print 系の出力を端末に表示しないようにする（ログファイルには引き続き記録する）
+func (l *Logger) SetQuiet(quiet bool) {
+	l.console.mu.Lock()
+	defer l.console.mu.Unlock()
+	l.console.quiet = quiet
 }
 
-// Infof フォーマット付き情報ログを出力
-func (l *Logger) Infof(format string, v ...interface{}) {
-	l.infoLogger.Printf(format, v...)
+// LogToStderr 詳細・情報ログと Print 系の出力を標準出力ではなく標準エラー出力に表示する（標準出力にデータを書き出す場合に使用）
+func (l *Logger) LogToStderr() {
+	l.console.mu.Lock()
+	defer l.console.mu.Unlock()
+	l.console.info = l.console.stderr
 }
 
-// Error エラーログを出力
-func (l *Logger) Error(v ...interface{}) {
-	l.errorLogger.Println(v...)
+// Print 端末のみに出力（ログファイルには出力しない。quiet の場合は出力しない）
+func (l *Logger) Print(v ...interface{}) {
+	fmt.Fprint(l.output(), v...)
 }
 
-// Errorf フォーマット付きエラーログを出力
-func (l *Logger) Errorf(format string, v ...interface{}) {
-	l.errorLogger.Printf(format, v...)
+// Printf フォーマット付きで端末のみに出力（ログファイルには出力しない。quiet の場合は出力しない）
+func (l *Logger) Printf(format string, v ...interface{}) {
+	fmt.Fprintf(l.output(), format, v...)
 }
 
-// Fatal 致命的エラーログを出力してプログラムを終了
-func (l *Logger) Fatal(v ...interface{}) {
-	l.errorLogger.Fatalln(v...)
+// Println 端末のみに出力（ログファイルには出力しない。quiet の場合は出力しない）
+func (l *Logger) Println(v ...interface{}) {
+	fmt.Fprintln(l.output(), v...)
 }
 
-// Fatalf フォーマット付き致命的エラーログを出力してプログラムを終了
-func (l *Logger) Fatalf(format string, v ...interface{}) {
-	l.errorLogger.Fatalf(format, v...)
+// output Print 系の出力先（詳細・情報ログと同じ表示先。quiet の場合は書き込まずに破棄する）
+func (l *Logger) output() io.Writer {
+	l.console.mu.Lock()
+	defer l.console.mu.Unlock()
+	if l.console.quiet {
+		return io.Discard
+	}
+	return l.console.info
 }
 
-// Verbose 詳細ログを出力（verboseフラグが有効な場合のみ）
-func (l *Logger) Verbose(v ...interface{}) {
-	if l.verbose {
-		l.infoLogger.Println(v...)
+// Close ログファイルをクローズ
+func (l *Logger) Close() error {
+	if l.closer != nil {
+		return l.closer.Close()
 	}
+	return nil
+}
+
+// consoleWriter 現在の設定に従って端末に書き込む（warn が true の場合は警告・エラーログの表示先）
+type consoleWriter struct {
+	c    *console
+	warn bool
 }
 
-// Verbosef フォーマット付き詳細ログを出力（verboseフラグが有効な場合のみ）
-func (l *Logger) Verbosef(format string, v ...interface{}) {
-	if l.verbose {
-		l.infoLogger.Printf(format, v...)
+func (w consoleWriter) Write(p []byte) (int, error) {
+	w.c.mu.Lock()
+	defer w.c.mu.Unlock()
+	if w.warn {
+		return w.c.stderr.Write(p)
 	}
+	return w.c.info.Write(p)
 }
 
-// Print 標準出力のみに出力（ログファイルには出力しない）
-func (l *Logger) Print(v ...interface{}) {
-	fmt.Print(v...)
+// consoleHandler レベルと quiet の設定に従って、ログを端末の表示先ごとのハンドラーに振り分ける
+type consoleHandler struct {
+	console *console
+	level   slog.Level
+	info    slog.Handler // 詳細・情報ログ
+	warn    slog.Handler // 警告・エラーログ
 }
 
-// Printf フォーマット付きで標準出力のみに出力（ログファイルには出力しない）
-func (l *Logger) Printf(format string, v ...interface{}) {
-	fmt.Printf(format, v...)
+func (h *consoleHandler) Enabled(_ context.Context, level slog.Level) bool {
+	if level < h.level {
+		return false
+	}
+	h.console.mu.Lock()
+	defer h.console.mu.Unlock()
+	return !h.console.quiet || level >= slog.LevelError
 }
 
-// Println 標準出力のみに出力（ログファイルには出力しない）
-func (l *Logger) Println(v ...interface{}) {
-	fmt.Println(v...)
+func (h *consoleHandler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level >= slog.LevelWarn {
+		return h.warn.Handle(ctx, r)
+	}
+	return h.info.Handle(ctx, r)
 }
 
-// Close ログファイルをクローズ
-func (l *Logger) Close() error {
-	if l.file != nil {
-		return l.file.Close()
+func (h *consoleHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &consoleHandler{console: h.console, level: h.level, info: h.info.WithAttrs(attrs), warn: h.warn.WithAttrs(attrs)}
+}
+
+func (h *consoleHandler) WithGroup(name string) slog.Handler {
+	return &consoleHandler{console: h.console, level: h.level, info: h.info.WithGroup(name), warn: h.warn.WithGroup(name)}
+}
+
+// textHandler 端末向けに "[LEVEL] メッセージ key=value ..." の1行を出力するハンドラー（レベルの判定は呼び出し側で行う）
+type textHandler struct {
+	w      io.Writer
+	attrs  string // WithAttrs で追加した属性（整形済み）
+	prefix string // WithGroup で追加したグループ名 ("group." の形式)
+}
+
+func (h *textHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *textHandler) Handle(_ context.Context, r slog.Record) error {
+	var b strings.Builder
+	b.WriteString("[" + r.Level.String() + "] ")
+	b.WriteString(r.Message)
+	b.WriteString(h.attrs)
+	r.Attrs(func(a slog.Attr) bool {
+		appendAttr(&b, h.prefix, a)
+		return true
+	})
+	b.WriteByte('\n')
+	_, err := io.WriteString(h.w, b.String())
+	return err
+}
+
+func (h *textHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	var b strings.Builder
+	b.WriteString(h.attrs)
+	for _, a := range attrs {
+		appendAttr(&b, h.prefix, a)
 	}
-	return nil
+	return &textHandler{w: h.w, attrs: b.String(), prefix: h.prefix}
+}
+
+func (h *textHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &textHandler{w: h.w, attrs: h.attrs, prefix: h.prefix + name + "."}
+}
+
+// appendAttr 属性を " key=value" の形式で追加（グループは "group.key" に展開し、空白などを含む値は引用符で囲む）
+func appendAttr(b *strings.Builder, prefix string, a slog.Attr) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return
+	}
+	if a.Value.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range a.Value.Group() {
+			appendAttr(b, prefix, ga)
+		}
+		return
+	}
+	value := a.Value.String()
+	if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+		value = strconv.Quote(value)
+	}
+	b.WriteString(" " + prefix + a.Key + "=" + value)
+}
+
+// fanoutHandler すべてのハンドラーにログを渡す
+type fanoutHandler []slog.Handler
+
+func (h fanoutHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, handler := range h {
+		if handler.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h fanoutHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, handler := range h {
+		if handler.Enabled(ctx, r.Level) {
+			errs = append(errs, handler.Handle(ctx, r.Clone()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h fanoutHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(fanoutHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithAttrs(attrs)
+	}
+	return handlers
+}
+
+func (h fanoutHandler) WithGroup(name string) slog.Handler {
+	handlers := make(fanoutHandler, len(h))
+	for i, handler := range h {
+		handlers[i] = handler.WithGroup(name)
+	}
+	return handlers
 }
@@ -0,0 +1,147 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// newTestLogger バッファに出力するロガーを作成
+func newTestLogger(opts Options) (l *Logger, file, stdout, stderr *bytes.Buffer) {
+	file, stdout, stderr = &bytes.Buffer{}, &bytes.Buffer{}, &bytes.Buffer{}
+	return NewWriter(file, stdout, stderr, opts), file, stdout, stderr
+}
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		name  string
+		want  slog.Level
+		valid bool
+	}{
+		{"debug", slog.LevelDebug, true},
+		{"INFO", slog.LevelInfo, true},
+		{"Warn", slog.LevelWarn, true},
+		{"error", slog.LevelError, true},
+		{"trace", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseLevel(tt.name)
+		if ok != tt.valid || got != tt.want {
+			t.Errorf("ParseLevel(%q) = %v, %v, want %v, %v", tt.name, got, ok, tt.want, tt.valid)
+		}
+	}
+}
+
+func TestTextOutput(t *testing.T) {
+	l, file, stdout, stderr := newTestLogger(Options{Level: slog.LevelInfo})
+
+	l.Debug("hidden", "appid", "440")
+	l.Info("fetched", "appid", "440", "reviews", 120)
+	l.Warn("no details", "appid", "440", "error", "not found")
+	l.Error("failed")
+
+	if got, want := stdout.String(), "[INFO] fetched appid=440 reviews=120\n"; got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+	if got, want := stderr.String(), "[WARN] no details appid=440 error=\"not found\"\n[ERROR] failed\n"; got != want {
+		t.Errorf("stderr = %q, want %q", got, want)
+	}
+
+	logged := file.String()
+	if strings.Contains(logged, "hidden") {
+		t.Errorf("log file contains debug record below the level: %q", logged)
+	}
+	for _, want := range []string{"level=INFO msg=fetched appid=440 reviews=120", "level=WARN", "level=ERROR msg=failed"} {
+		if !strings.Contains(logged, want) {
+			t.Errorf("log file does not contain %q: %q", want, logged)
+		}
+	}
+	if strings.Contains(logged, ".go:") {
+		t.Errorf("log file contains source location: %q", logged)
+	}
+}
+
+func TestDebugLevel(t *testing.T) {
+	l, file, stdout, _ := newTestLogger(Options{Level: slog.LevelDebug})
+
+	l.Debug("page", "cursor", "*")
+
+	if got, want := stdout.String(), "[DEBUG] page cursor=*\n"; got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+	if !strings.Contains(file.String(), "level=DEBUG msg=page") {
+		t.Errorf("log file = %q, want debug record", file.String())
+	}
+}
+
+func TestWithAttrs(t *testing.T) {
+	l, _, stdout, _ := newTestLogger(Options{Level: slog.LevelInfo})
+
+	l.With("appid", "440").WithGroup("page").Info("fetched", "number", 2)
+
+	if got, want := stdout.String(), "[INFO] fetched appid=440 page.number=2\n"; got != want {
+		t.Errorf("stdout = %q, want %q", got, want)
+	}
+}
+
+func TestQuiet(t *testing.T) {
+	l, file, stdout, stderr := newTestLogger(Options{Level: slog.LevelInfo})
+	l.SetQuiet(true)
+
+	l.Info("progress")
+	l.Warn("warning")
+	l.Error("failed")
+	l.Println("statistics")
+
+	if stdout.Len() != 0 {
+		t.Errorf("stdout = %q, want empty", stdout.String())
+	}
+	if got, want := stderr.String(), "[ERROR] failed\n"; got != want {
+		t.Errorf("stderr = %q, want %q", got, want)
+	}
+	// ログファイルには quiet でも記録する
+	for _, want := range []string{"msg=progress", "msg=warning", "msg=failed"} {
+		if !strings.Contains(file.String(), want) {
+			t.Errorf("log file does not contain %q: %q", want, file.String())
+		}
+	}
+}
+
+func TestLogToStderr(t *testing.T) {
+	l, _, stdout, stderr := newTestLogger(Options{Level: slog.LevelInfo})
+	l.LogToStderr()
+
+	l.Info("saved")
+	l.Println("- output/steam_reviews_440.json")
+
+	if stdout.Len() != 0 {
+		t.Errorf("stdout = %q, want empty", stdout.String())
+	}
+	if got, want := stderr.String(), "[INFO] saved\n- output/steam_reviews_440.json\n"; got != want {
+		t.Errorf("stderr = %q, want %q", got, want)
+	}
+}
+
+func TestJSONOutput(t *testing.T) {
+	l, file, stdout, stderr := newTestLogger(Options{Level: slog.LevelInfo, JSON: true})
+
+	l.Info("fetched", "appid", "440", "reviews", 120)
+	l.Error("failed", "appid", "570")
+
+	for name, buf := range map[string]*bytes.Buffer{"stdout": stdout, "file": file} {
+		var record map[string]any
+		line, _, _ := strings.Cut(buf.String(), "\n")
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("%s is not JSON: %v: %q", name, err, buf.String())
+		}
+		if record["level"] != "INFO" || record["msg"] != "fetched" || record["appid"] != "440" || record["reviews"] != float64(120) {
+			t.Errorf("%s record = %v", name, record)
+		}
+	}
+	if !strings.Contains(stderr.String(), `"level":"ERROR"`) || !strings.Contains(stderr.String(), `"appid":"570"`) {
+		t.Errorf("stderr = %q, want JSON error record", stderr.String())
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/y-moriya/steam-review/pkg/config"
 )
 
 // GameDetails ゲーム詳細情報構造体
@@ -27,6 +29,19 @@ type GameDetails struct {
 	RetrievedAt time.Time `json:"retrieved_at"`
 }
 
+// 取得が完了しなかった理由
+const (
+	IncompleteInterrupted  = "interrupted"   // シグナル (Ctrl+C など) による中断
+	IncompleteNetworkError = "network_error" // 取得途中の通信エラー
+)
+
+// FetchMetadata 保存したレビューの取得に関する情報
+type FetchMetadata struct {
+	Incomplete bool      `json:"incomplete"`       // 取得が途中で終了したかどうか
+	Reason     string    `json:"reason,omitempty"` // 取得が完了しなかった理由 (例: "interrupted")
+	FetchedAt  time.Time `json:"fetched_at"`       // 取得した日時
+}
+
 // SteamAppDetailsResponse Steam Store APIからのレスポンス構造体
 type SteamAppDetailsResponse struct {
 	Success bool `json:"success"`
@@ -116,19 +131,28 @@ func ConvertToGameDetails(appID string, response SteamAppDetailsResponse) GameDe
 	}
 }
 
+// AppListEntry Steamのアプリ一覧の1件
+type AppListEntry struct {
+	AppID string `json:"app_id"`
+	Name  string `json:"name"`
+}
+
+// QuerySummary Steam APIのレビュー集計情報（最初のページのみ含まれる）
+type QuerySummary struct {
+	NumReviews      int    `json:"num_reviews"`
+	ReviewScore     int    `json:"review_score"`
+	ReviewScoreDesc string `json:"review_score_desc"`
+	TotalPositive   int    `json:"total_positive"`
+	TotalNegative   int    `json:"total_negative"`
+	TotalReviews    int    `json:"total_reviews"`
+}
+
 // SteamReviewResponse Steam APIからのレスポンス構造体
 type SteamReviewResponse struct {
-	Success      int `json:"success"`
-	QuerySummary struct {
-		NumReviews      int    `json:"num_reviews"`
-		ReviewScore     int    `json:"review_score"`
-		ReviewScoreDesc string `json:"review_score_desc"`
-		TotalPositive   int    `json:"total_positive"`
-		TotalNegative   int    `json:"total_negative"`
-		TotalReviews    int    `json:"total_reviews"`
-	} `json:"query_summary"`
-	Reviews []SteamReview `json:"reviews"`
-	Cursor  string        `json:"cursor"`
+	Success      int           `json:"success"`
+	QuerySummary QuerySummary  `json:"query_summary"`
+	Reviews      []SteamReview `json:"reviews"`
+	Cursor       string        `json:"cursor"`
 }
 
 // FlexibleFloat64 文字列または数値を float64 として受け取るカスタム型
@@ -196,6 +220,7 @@ type ReviewData struct {
 	RecommendationID     string     `json:"recommendation_id"`
 	Author               AuthorData `json:"author"`
 	Language             string     `json:"language"`
+	LanguageISO          string     `json:"language_iso,omitempty"` // 言語のISO/BCP 47 タグ (例: "ja", "zh-TW")
 	Review               string     `json:"review"`
 	TimestampCreated     int64      `json:"timestamp_created"`
 	TimestampUpdated     int64      `json:"timestamp_updated"`
@@ -209,6 +234,7 @@ type ReviewData struct {
 	WrittenDuringEA      bool       `json:"written_during_early_access"`
 	DeveloperResponse    string     `json:"developer_response,omitempty"`
 	TimestampDevResponse int64      `json:"timestamp_dev_responded,omitempty"`
+	SentimentScore       *float64   `json:"sentiment_score,omitempty"` // 辞書ベースの感情スコア (-1〜1, 未計算の場合はnil)
 }
 
 // AuthorData 作成者データ構造体
@@ -236,6 +262,7 @@ func ConvertSteamReview(sr SteamReview) ReviewData {
 			LastPlayed:           sr.Author.LastPlayed,
 		},
 		Language:             sr.Language,
+		LanguageISO:          config.LanguageISO(sr.Language),
 		Review:               sr.Review,
 		TimestampCreated:     sr.TimestampCreated,
 		TimestampUpdated:     sr.TimestampUpdated,
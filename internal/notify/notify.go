@@ -0,0 +1,299 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+const (
+	// DefaultBatchSize 1回の通知に含める最大レビュー数のデフォルト値
+	DefaultBatchSize = 10
+	// DefaultRetries 通知の送信に失敗した場合の再試行回数のデフォルト値
+	DefaultRetries = 3
+	// DefaultRetryDelay 最初の再試行までの待ち時間（再試行ごとに倍にする）
+	DefaultRetryDelay = time.Second
+
+	// discordMaxContent Discordのメッセージ本文の最大文字数
+	discordMaxContent = 2000
+)
+
+// defaultTemplate Slack・Discord形式のデフォルトのメッセージテンプレート（本文は200文字まで）
+const defaultTemplate = `{{.Title}}
+{{range .Reviews}}{{if .VotedUp}}👍{{else}}👎{{end}} {{truncate .Review 200}}
+https://steamcommunity.com/profiles/{{.Author.SteamID}}/recommended/{{$.AppID}}/
+{{end}}`
+
+// Options 通知の設定
+type Options struct {
+	URL        string        // 送信先のWebhook URL
+	Format     string        // 送信する本文の形式 (json, slack, discord)
+	Template   string        // 本文のテンプレート (text/template。空の場合はデフォルト)
+	Events     []string      // 通知する条件 (new, negative, keywords)
+	Negative   int           // 否定的レビューの通知のしきい値（新しい否定的レビューの件数）
+	Keywords   []string      // 本文に含まれていれば通知するキーワード
+	BatchSize  int           // 1回の通知に含める最大レビュー数
+	Retries    int           // 送信に失敗した場合の再試行回数
+	RetryDelay time.Duration // 最初の再試行までの待ち時間
+	Client     *http.Client  // 送信に使うHTTPクライアント (nil の場合は http.DefaultClient)
+	Logger     Logger        // 再試行を記録するロガー (nil の場合は記録しない)
+}
+
+// Logger 送信に失敗して再試行するたびに、待ち時間と試行回数 (attempt, delay) を記録する警告ログ
+type Logger interface {
+	Warn(msg string, args ...any)
+}
+
+// Message 1回の通知の内容（JSON形式の本文およびテンプレートのデータ）
+type Message struct {
+	Event    string              `json:"event"`              // 通知の条件 (new, negative, keywords)
+	Title    string              `json:"title"`              // 翻訳済みの見出し
+	AppID    string              `json:"app_id"`             // ゲームのApp ID
+	GameName string              `json:"game_name"`          // ゲーム名
+	Keywords []string            `json:"keywords,omitempty"` // 一致したキーワード
+	Total    int                 `json:"total"`              // 条件に該当したレビューの総数
+	Batch    int                 `json:"batch"`              // 分割した通知の番号 (1から)
+	Batches  int                 `json:"batches"`            // 分割した通知の数
+	Reviews  []models.ReviewData `json:"reviews"`            // この通知に含めるレビュー
+}
+
+// Notifier 条件に該当するレビューをWebhookに通知する
+type Notifier struct {
+	opts Options
+	tmpl *template.Template
+}
+
+// New 設定から Notifier を作成（テンプレートの構文エラーはここで返す）
+func New(opts Options) (*Notifier, error) {
+	if opts.Format == "" {
+		opts.Format = config.WebhookFormatJSON
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = DefaultRetryDelay
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+
+	n := &Notifier{opts: opts}
+	text := opts.Template
+	if text == "" && opts.Format != config.WebhookFormatJSON {
+		text = defaultTemplate
+	}
+	if text != "" {
+		tmpl, err := template.New("webhook").Funcs(template.FuncMap{"truncate": truncate}).Parse(text)
+		if err != nil {
+			return nil, errors.New(i18n.Tf(i18n.MsgErrorWebhookTemplate, err))
+		}
+		n.tmpl = tmpl
+	}
+	return n, nil
+}
+
+// SetLogger 再試行を記録するロガーを設定（Notifier をロガーより先に作成する場合に使用）
+func (n *Notifier) SetLogger(logger Logger) {
+	n.opts.Logger = logger
+}
+
+// Messages 新しいレビューから通知する内容を作成（条件ごとに BatchSize 件ずつ分割）
+func (n *Notifier) Messages(appID, gameName string, reviews []models.ReviewData) []Message {
+	if gameName == "" {
+		gameName = fmt.Sprintf("App ID %s", appID)
+	}
+
+	var messages []Message
+	for _, event := range n.opts.Events {
+		var matched []models.ReviewData
+		var keywords []string
+		var title string
+		switch event {
+		case config.NotifyNew:
+			matched = reviews
+			title = i18n.Tf(i18n.MsgNotifyTitleNew, gameName, len(matched))
+		case config.NotifyNegative:
+			for _, review := range reviews {
+				if !review.VotedUp {
+					matched = append(matched, review)
+				}
+			}
+			if len(matched) < n.opts.Negative {
+				continue
+			}
+			title = i18n.Tf(i18n.MsgNotifyTitleNegative, gameName, len(matched), n.opts.Negative)
+		case config.NotifyKeywords:
+			matched, keywords = matchKeywords(reviews, n.opts.Keywords)
+			title = i18n.Tf(i18n.MsgNotifyTitleKeywords, gameName, len(matched), strings.Join(keywords, ", "))
+		}
+		if len(matched) == 0 {
+			continue
+		}
+
+		batches := (len(matched) + n.opts.BatchSize - 1) / n.opts.BatchSize
+		for i := 0; i < batches; i++ {
+			end := min((i+1)*n.opts.BatchSize, len(matched))
+			m := Message{
+				Event:    event,
+				Title:    title,
+				AppID:    appID,
+				GameName: gameName,
+				Keywords: keywords,
+				Total:    len(matched),
+				Batch:    i + 1,
+				Batches:  batches,
+				Reviews:  matched[i*n.opts.BatchSize : end],
+			}
+			if batches > 1 {
+				m.Title = i18n.Tf(i18n.MsgNotifyTitleBatch, title, m.Batch, m.Batches)
+			}
+			messages = append(messages, m)
+		}
+	}
+	return messages
+}
+
+// Notify 新しいレビューのうち条件に該当するものを通知し、送信した通知の数を返す
+//
+// 送信に失敗した通知があっても残りの通知は送信し、最後のエラーを返す。
+func (n *Notifier) Notify(appID, gameName string, reviews []models.ReviewData) (int, error) {
+	return n.NotifyContext(context.Background(), appID, gameName, reviews)
+}
+
+// NotifyContext Notify の ctx でキャンセルできる版（キャンセルされると送信中のリクエストと再試行の待ち時間を中断する）
+func (n *Notifier) NotifyContext(ctx context.Context, appID, gameName string, reviews []models.ReviewData) (int, error) {
+	sent := 0
+	var lastErr error
+	for _, m := range n.Messages(appID, gameName, reviews) {
+		body, err := n.Body(m)
+		if err == nil {
+			err = n.post(ctx, body)
+		}
+		if err != nil {
+			lastErr = err
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+		sent++
+	}
+	return sent, lastErr
+}
+
+// Body 設定された形式で通知の本文を作成
+//
+// json 形式ではテンプレートを指定した場合のみテンプレートの出力をそのまま本文にする。
+// slack, discord 形式ではテンプレートの出力をメッセージのテキストにする。
+func (n *Notifier) Body(m Message) ([]byte, error) {
+	if n.opts.Format == config.WebhookFormatJSON && n.tmpl == nil {
+		return json.Marshal(m)
+	}
+
+	var buf bytes.Buffer
+	if err := n.tmpl.Execute(&buf, m); err != nil {
+		return nil, errors.New(i18n.Tf(i18n.MsgErrorWebhookTemplate, err))
+	}
+	text := strings.TrimSpace(buf.String())
+
+	switch n.opts.Format {
+	case config.WebhookFormatSlack:
+		return json.Marshal(map[string]string{"text": text})
+	case config.WebhookFormatDiscord:
+		return json.Marshal(map[string]string{"content": truncate(text, discordMaxContent)})
+	default:
+		return []byte(text), nil
+	}
+}
+
+// post 本文をPOSTする（通信エラー・429・5xxの場合は待ち時間を倍にしながら再試行）
+func (n *Notifier) post(ctx context.Context, body []byte) error {
+	delay := n.opts.RetryDelay
+	var err error
+	for attempt := 0; ; attempt++ {
+		var retry bool
+		retry, err = n.send(ctx, body)
+		if err == nil || !retry || attempt >= n.opts.Retries {
+			return err
+		}
+		if n.opts.Logger != nil {
+			n.opts.Logger.Warn(i18n.Tf(i18n.MsgNotifyRetry, delay, err), "attempt", attempt+1, "delay", delay)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// send 本文を1回POSTし、失敗した場合は再試行すべきかどうかを返す
+func (n *Notifier) send(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.opts.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.New(i18n.Tf(i18n.MsgErrorWebhookSend, err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.opts.Client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, errors.New(i18n.Tf(i18n.MsgErrorWebhookSend, err))
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retry, errors.New(i18n.Tf(i18n.MsgErrorWebhookStatus, resp.Status))
+}
+
+// matchKeywords 本文にキーワードを含むレビューと、一致したキーワードを返す（大文字小文字は区別しない）
+func matchKeywords(reviews []models.ReviewData, keywords []string) ([]models.ReviewData, []string) {
+	var matched []models.ReviewData
+	var found []string
+	seen := make(map[string]bool)
+	for _, review := range reviews {
+		text := strings.ToLower(review.Review)
+		hit := false
+		for _, keyword := range keywords {
+			if keyword == "" || !strings.Contains(text, strings.ToLower(keyword)) {
+				continue
+			}
+			hit = true
+			if !seen[keyword] {
+				seen[keyword] = true
+				found = append(found, keyword)
+			}
+		}
+		if hit {
+			matched = append(matched, review)
+		}
+	}
+	return matched, found
+}
+
+// truncate 文字列を最大 limit 文字に切り詰める（切り詰めた場合は末尾を "…" にする）
+func truncate(s string, limit int) string {
+	r := []rune(s)
+	if len(r) <= limit {
+		return s
+	}
+	if limit <= 1 {
+		return string(r[:max(limit, 0)])
+	}
+	return string(r[:limit-1]) + "…"
+}
@@ -0,0 +1,225 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/config"
+)
+
+// testReviews 肯定的レビュー2件と否定的レビュー3件
+func testReviews() []models.ReviewData {
+	return []models.ReviewData{
+		{RecommendationID: "1", VotedUp: true, Review: "Great game"},
+		{RecommendationID: "2", VotedUp: false, Review: "Crashes on startup"},
+		{RecommendationID: "3", VotedUp: false, Review: "Want a REFUND"},
+		{RecommendationID: "4", VotedUp: true, Review: "Fun with friends"},
+		{RecommendationID: "5", VotedUp: false, Review: "crash after the update, refund please"},
+	}
+}
+
+// recorder 受け取ったリクエストの本文を記録するテスト用のWebhook
+type recorder struct {
+	mu       sync.Mutex
+	bodies   []string
+	statuses []int // 順に返すステータス (使い切った後は200)
+}
+
+func (r *recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.bodies = append(r.bodies, string(body))
+	if len(r.statuses) > 0 {
+		w.WriteHeader(r.statuses[0])
+		r.statuses = r.statuses[1:]
+	}
+}
+
+func TestMessages(t *testing.T) {
+	n, err := New(Options{
+		Events:    []string{config.NotifyNew, config.NotifyNegative, config.NotifyKeywords},
+		Negative:  3,
+		Keywords:  []string{"crash", "refund", "lag"},
+		BatchSize: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	messages := n.Messages("440", "Test Game", testReviews())
+	var events []string
+	for _, m := range messages {
+		events = append(events, m.Event)
+	}
+	// new: 5件を2件ずつ3回, negative: 3件を2回, keywords: 3件を2回
+	want := "new new new negative negative keywords keywords"
+	if got := strings.Join(events, " "); got != want {
+		t.Fatalf("events = %q, want %q", got, want)
+	}
+
+	last := messages[2]
+	if last.Batch != 3 || last.Batches != 3 || last.Total != 5 || len(last.Reviews) != 1 {
+		t.Errorf("last new batch = %d/%d total=%d reviews=%d, want 3/3 total=5 reviews=1", last.Batch, last.Batches, last.Total, len(last.Reviews))
+	}
+	keywords := messages[5]
+	if strings.Join(keywords.Keywords, ",") != "crash,refund" || keywords.Total != 3 {
+		t.Errorf("keywords = %v total=%d, want [crash refund] total=3", keywords.Keywords, keywords.Total)
+	}
+
+	// しきい値未満の否定的レビューは通知しない
+	n.opts.Negative = 4
+	for _, m := range n.Messages("440", "Test Game", testReviews()) {
+		if m.Event == config.NotifyNegative {
+			t.Errorf("negative notification below threshold: %+v", m)
+		}
+	}
+}
+
+func TestBody(t *testing.T) {
+	m := Message{Event: config.NotifyNew, Title: "Test Game: 1 new reviews", AppID: "440", GameName: "Test Game", Total: 1, Batch: 1, Batches: 1,
+		Reviews: []models.ReviewData{{RecommendationID: "1", VotedUp: true, Review: strings.Repeat("a", 300)}}}
+
+	tests := []struct {
+		name     string
+		format   string
+		template string
+		check    func(t *testing.T, body []byte)
+	}{
+		{
+			name:   "JSON",
+			format: config.WebhookFormatJSON,
+			check: func(t *testing.T, body []byte) {
+				var got Message
+				if err := json.Unmarshal(body, &got); err != nil || got.AppID != "440" || len(got.Reviews) != 1 {
+					t.Errorf("body = %s, err = %v", body, err)
+				}
+			},
+		},
+		{
+			name:   "Slack",
+			format: config.WebhookFormatSlack,
+			check: func(t *testing.T, body []byte) {
+				var got map[string]string
+				if err := json.Unmarshal(body, &got); err != nil || !strings.HasPrefix(got["text"], "Test Game: 1 new reviews\n👍 ") || !strings.Contains(got["text"], "a…") {
+					t.Errorf("body = %s, err = %v", body, err)
+				}
+			},
+		},
+		{
+			name:     "Discord with template",
+			format:   config.WebhookFormatDiscord,
+			template: "{{.GameName}} {{len .Reviews}}",
+			check: func(t *testing.T, body []byte) {
+				if string(body) != `{"content":"Test Game 1"}` {
+					t.Errorf("body = %s", body)
+				}
+			},
+		},
+		{
+			name:     "JSON with template",
+			format:   config.WebhookFormatJSON,
+			template: `{"game": "{{.AppID}}", "count": {{.Total}}}`,
+			check: func(t *testing.T, body []byte) {
+				if string(body) != `{"game": "440", "count": 1}` {
+					t.Errorf("body = %s", body)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := New(Options{Format: tt.format, Template: tt.template})
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := n.Body(m)
+			if err != nil {
+				t.Fatalf("Body() error = %v", err)
+			}
+			tt.check(t, body)
+		})
+	}
+
+	if _, err := New(Options{Template: "{{.Title"}); err == nil {
+		t.Error("New() with invalid template should return an error")
+	}
+}
+
+func TestNotify(t *testing.T) {
+	rec := &recorder{statuses: []int{http.StatusInternalServerError, http.StatusTooManyRequests}}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n, err := New(Options{URL: server.URL, Format: config.WebhookFormatSlack, Events: []string{config.NotifyNew},
+		BatchSize: 3, Retries: 2, RetryDelay: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 1件目は2回失敗してから成功し、2件目は1回で成功する
+	sent, err := n.Notify("440", "Test Game", testReviews())
+	if err != nil || sent != 2 {
+		t.Fatalf("Notify() = %d, %v, want 2, nil", sent, err)
+	}
+	if len(rec.bodies) != 4 {
+		t.Errorf("requests = %d, want 4", len(rec.bodies))
+	}
+	if !strings.Contains(rec.bodies[3], "(2/2)") {
+		t.Errorf("last body = %s, want batch 2/2", rec.bodies[3])
+	}
+
+	// 4xx は再試行しない
+	rec.bodies = nil
+	rec.statuses = []int{http.StatusBadRequest}
+	sent, err = n.Notify("440", "Test Game", testReviews()[:1])
+	if err == nil || sent != 0 || len(rec.bodies) != 1 {
+		t.Errorf("Notify() = %d, %v with %d requests, want error without retry", sent, err, len(rec.bodies))
+	}
+}
+
+func TestNotifyContextCanceled(t *testing.T) {
+	rec := &recorder{statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable}}
+	server := httptest.NewServer(rec)
+	defer server.Close()
+
+	n, err := New(Options{URL: server.URL, Events: []string{config.NotifyNew},
+		BatchSize: 1, Retries: 3, RetryDelay: time.Hour})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 再試行の待ち時間中にキャンセルすると、待たずに残りの通知も送信せずに終了する
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	sent, err := n.NotifyContext(ctx, "440", "Test Game", testReviews())
+	if !errors.Is(err, context.DeadlineExceeded) || sent != 0 {
+		t.Errorf("NotifyContext() = %d, %v, want 0, context.DeadlineExceeded", sent, err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("NotifyContext() took %v after cancellation", elapsed)
+	}
+	if len(rec.bodies) != 1 {
+		t.Errorf("requests = %d, want 1", len(rec.bodies))
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if got := truncate("日本語のレビュー", 4); got != "日本語…" {
+		t.Errorf("truncate() = %q, want %q", got, "日本語…")
+	}
+	if got := truncate("short", 10); got != "short" {
+		t.Errorf("truncate() = %q, want %q", got, "short")
+	}
+}
@@ -0,0 +1,112 @@
+package progress
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// LogInterval 端末以外に出力する場合に進捗を記録する間隔
+const LogInterval = 10 * time.Second
+
+// maxBarWidth 進捗バーの最大幅（桁数）
+const maxBarWidth = 30
+
+// Bar レビュー取得の進捗を表示する
+//
+// 端末に出力する場合は同じ行に進捗バーを描き直し、それ以外の場合は LogInterval ごとに logLine で1行記録する。
+type Bar struct {
+	w       io.Writer
+	tty     bool
+	width   int
+	logLine func(string)
+	now     func() time.Time
+
+	start   time.Time
+	lastLog time.Time
+	drawn   bool // 端末に進捗バーを描いたかどうか
+
+	fetched int
+	pages   int
+	total   int
+}
+
+// New 進捗表示を作成
+//
+// tty が true の場合は w に幅 width の進捗バーを描く。false の場合は logLine に進捗を渡す。
+func New(w io.Writer, tty bool, width int, logLine func(string)) *Bar {
+	now := time.Now()
+	return &Bar{w: w, tty: tty, width: width, logLine: logLine, now: time.Now, start: now, lastLog: now}
+}
+
+// Update 進捗を更新（api.ProgressFunc として使用）
+func (b *Bar) Update(fetched, pages, total int) {
+	b.fetched, b.pages, b.total = fetched, pages, total
+	if b.tty {
+		fmt.Fprintf(b.w, "\r%s\033[K", b.Line())
+		b.drawn = true
+		return
+	}
+	if now := b.now(); now.Sub(b.lastLog) >= LogInterval {
+		b.lastLog = now
+		b.logLine(b.status())
+	}
+}
+
+// Finish 進捗バーの行を確定する（nil の場合は何もしない）
+func (b *Bar) Finish() {
+	if b == nil || !b.drawn {
+		return
+	}
+	fmt.Fprintln(b.w)
+	b.drawn = false
+}
+
+// Line 端末に表示する1行（見込みの件数がわかる場合は進捗バーを含む）
+func (b *Bar) Line() string {
+	status := b.status()
+	if b.total <= 0 {
+		return status
+	}
+	barWidth := min(b.width-displayWidth(status)-3, maxBarWidth)
+	if barWidth < 10 {
+		return status
+	}
+	filled := min(b.fetched*barWidth/b.total, barWidth)
+	return "[" + strings.Repeat("=", filled) + strings.Repeat(" ", barWidth-filled) + "] " + status
+}
+
+// status 件数・ページ数・速度・残り時間
+func (b *Bar) status() string {
+	elapsed := b.now().Sub(b.start).Seconds()
+	rate := 0.0
+	if elapsed > 0 {
+		rate = float64(b.fetched) / elapsed
+	}
+	if b.total <= 0 {
+		return i18n.Tf(i18n.MsgProgressUnknown, b.fetched, b.pages, rate)
+	}
+
+	eta := "?"
+	if rate > 0 {
+		remaining := max(b.total-b.fetched, 0)
+		eta = time.Duration(float64(remaining) / rate * float64(time.Second)).Round(time.Second).String()
+	}
+	return i18n.Tf(i18n.MsgProgressStatus, b.fetched, b.total, min(b.fetched*100/b.total, 100), b.pages, rate, eta)
+}
+
+// displayWidth 端末での表示幅（全角文字は2桁として数える）
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		if r >= 0x1100 {
+			width += 2
+		} else {
+			width++
+		}
+	}
+	return width
+}
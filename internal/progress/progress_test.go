@@ -0,0 +1,71 @@
+package progress
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestBar 時刻を進められる進捗表示を作成
+func newTestBar(tty bool, lines *[]string) (*Bar, *bytes.Buffer, *time.Time) {
+	var buf bytes.Buffer
+	b := New(&buf, tty, 100, func(line string) { *lines = append(*lines, line) })
+	now := b.start
+	b.now = func() time.Time { return now }
+	return b, &buf, &now
+}
+
+func TestBarTerminal(t *testing.T) {
+	var lines []string
+	b, buf, now := newTestBar(true, &lines)
+
+	*now = now.Add(10 * time.Second)
+	b.Update(250, 3, 1000)
+	out := buf.String()
+	if !strings.HasPrefix(out, "\r[") || !strings.Contains(out, "250/1000") || !strings.Contains(out, "25%") {
+		t.Errorf("output = %q, want a progress bar with 250/1000 (25%%)", out)
+	}
+	// 25件/秒で残り750件
+	if !strings.Contains(out, "30s") {
+		t.Errorf("output = %q, want ETA 30s", out)
+	}
+	if len(lines) != 0 {
+		t.Errorf("log lines = %v, want none on a terminal", lines)
+	}
+
+	b.Finish()
+	if !strings.HasSuffix(buf.String(), "\n") {
+		t.Error("Finish() should end the progress line")
+	}
+}
+
+func TestBarLog(t *testing.T) {
+	var lines []string
+	b, buf, now := newTestBar(false, &lines)
+
+	*now = now.Add(time.Second)
+	b.Update(100, 1, 0)
+	if len(lines) != 0 {
+		t.Fatalf("log lines = %v, want none before LogInterval", lines)
+	}
+	*now = now.Add(LogInterval)
+	b.Update(200, 2, 0)
+	if len(lines) != 1 || !strings.HasPrefix(lines[0], "200 reviews, 2 pages") {
+		t.Errorf("log lines = %v, want one line for 200 reviews", lines)
+	}
+	b.Finish()
+	if buf.Len() != 0 {
+		t.Errorf("output = %q, want nothing when not a terminal", buf.String())
+	}
+
+	var nilBar *Bar
+	nilBar.Finish()
+}
+
+func TestLineNarrowTerminal(t *testing.T) {
+	b := &Bar{width: 20, now: time.Now, start: time.Now(), fetched: 1, pages: 1, total: 10}
+	if line := b.Line(); strings.HasPrefix(line, "[") {
+		t.Errorf("Line() = %q, want no bar on a narrow terminal", line)
+	}
+}
@@ -0,0 +1,262 @@
+package sentiment
+
+import (
+	"bufio"
+	"embed"
+	"errors"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/internal/text"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+//go:embed lexicons/*.tsv
+var bundledLexicons embed.FS
+
+// ErrNoLexicons 辞書ディレクトリに "*.tsv" の辞書ファイルがない
+var ErrNoLexicons = errors.New("no lexicon files")
+
+// normalizationAlpha スコアを -1〜1 に正規化する際の定数（VADERと同じ値）
+const normalizationAlpha = 15
+
+// englishNegations 直後の語の極性を反転する英語の否定語
+var englishNegations = map[string]bool{
+	"not": true, "no": true, "never": true, "nothing": true, "without": true,
+	"don't": true, "dont": true, "doesn't": true, "doesnt": true, "didn't": true, "didnt": true,
+	"isn't": true, "isnt": true, "wasn't": true, "wasnt": true, "aren't": true, "arent": true,
+	"can't": true, "cant": true, "cannot": true, "won't": true, "wont": true, "hardly": true,
+}
+
+// japaneseNegations 直後に続くと極性を反転する日本語の否定表現
+var japaneseNegations = []string{"ない", "なく", "なか", "ません", "ず"}
+
+// Lexicon 語ごとの感情スコア辞書
+type Lexicon struct {
+	entries map[string]float64
+	maxLen  int  // 登録語の最大文字数（最長一致用）
+	cjk     bool // 空白で区切られない言語の辞書か
+}
+
+// ParseLexicon "語<TAB>スコア" 形式の辞書を読み込む
+//
+// 空行と "#" で始まる行は無視する。
+func ParseLexicon(r io.Reader) (*Lexicon, error) {
+	lex := &Lexicon{entries: make(map[string]float64)}
+
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) != 2 {
+			return nil, errors.New(i18n.Tf(i18n.MsgErrorLexiconLine, lineNum, line))
+		}
+		score, err := strconv.ParseFloat(strings.TrimSpace(fields[1]), 64)
+		if err != nil {
+			return nil, errors.New(i18n.Tf(i18n.MsgErrorLexiconLine, lineNum, line))
+		}
+		term := strings.ToLower(strings.TrimSpace(fields[0]))
+		lex.entries[term] = score
+
+		runes := []rune(term)
+		if len(runes) > lex.maxLen {
+			lex.maxLen = len(runes)
+		}
+		if text.IsCJK(runes[0]) {
+			lex.cjk = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return lex, nil
+}
+
+// Len 登録語数を返す
+func (l *Lexicon) Len() int {
+	return len(l.entries)
+}
+
+// Score テキストの感情スコアを計算する
+//
+// 戻り値は -1〜1 に正規化したスコアと、辞書の語が1つ以上見つかったかどうか。
+func (l *Lexicon) Score(s string) (float64, bool) {
+	var sum float64
+	var hits int
+	if l.cjk {
+		sum, hits = l.scoreCJK(text.Clean(s))
+	} else {
+		sum, hits = l.scoreWords(text.Tokens(s))
+	}
+	if hits == 0 {
+		return 0, false
+	}
+	return sum / math.Sqrt(sum*sum+normalizationAlpha), true
+}
+
+// scoreWords 単語単位で辞書を引いてスコアを合計（直前2語以内の否定語で反転）
+func (l *Lexicon) scoreWords(tokens []string) (float64, int) {
+	var sum float64
+	var hits int
+	for i, token := range tokens {
+		score, ok := l.entries[token]
+		if !ok {
+			continue
+		}
+		for j := i - 1; j >= 0 && j >= i-2; j-- {
+			if englishNegations[tokens[j]] {
+				score = -score
+				break
+			}
+		}
+		sum += score
+		hits++
+	}
+	return sum, hits
+}
+
+// scoreCJK 最長一致で辞書を引いてスコアを合計（直後の否定表現で反転）
+func (l *Lexicon) scoreCJK(s string) (float64, int) {
+	runes := []rune(strings.ToLower(s))
+	var sum float64
+	var hits int
+	for i := 0; i < len(runes); {
+		matched := 0
+		for n := l.maxLen; n > 0; n-- {
+			if i+n > len(runes) {
+				continue
+			}
+			score, ok := l.entries[string(runes[i:i+n])]
+			if !ok {
+				continue
+			}
+			if followedByNegation(runes[i+n:]) {
+				score = -score
+			}
+			sum += score
+			hits++
+			matched = n
+			break
+		}
+		if matched == 0 {
+			matched = 1
+		}
+		i += matched
+	}
+	return sum, hits
+}
+
+// followedByNegation 活用語尾（最大2文字）の後に否定表現が続くか
+func followedByNegation(rest []rune) bool {
+	for skip := 0; skip <= 2 && skip < len(rest); skip++ {
+		if skip > 0 && !isKana(rest[skip-1]) {
+			return false
+		}
+		tail := string(rest[skip:])
+		for _, neg := range japaneseNegations {
+			if strings.HasPrefix(tail, neg) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isKana ひらがなかどうか（活用語尾の判定用）
+func isKana(r rune) bool {
+	return r >= 'ぁ' && r <= 'ゖ'
+}
+
+// Analyzer Steamの言語コードごとに辞書を切り替えて感情スコアを計算する
+type Analyzer struct {
+	lexicons map[string]*Lexicon
+}
+
+// NewAnalyzer 同梱の辞書（english, japanese）を読み込んだAnalyzerを作成
+func NewAnalyzer() (*Analyzer, error) {
+	a := &Analyzer{lexicons: make(map[string]*Lexicon)}
+
+	entries, err := bundledLexicons.ReadDir("lexicons")
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		file, err := bundledLexicons.Open("lexicons/" + entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		lex, err := ParseLexicon(file)
+		file.Close()
+		if err != nil {
+			return nil, err
+		}
+		a.lexicons[strings.TrimSuffix(entry.Name(), ".tsv")] = lex
+	}
+
+	return a, nil
+}
+
+// LoadLexiconDir ディレクトリ内の "<Steam言語コード>.tsv" を読み込み、同梱の辞書を置き換える
+//
+// 辞書ファイルが1つもない場合（ディレクトリが存在しない場合を含む）は ErrNoLexicons を返す。
+func (a *Analyzer) LoadLexiconDir(dir string) error {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.tsv"))
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		// 存在しないディレクトリも一致なしになるため、同梱の辞書のまま続行しないようにする
+		return ErrNoLexicons
+	}
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		lex, err := ParseLexicon(file)
+		file.Close()
+		if err != nil {
+			return errors.New(i18n.Tf(i18n.MsgErrorLexiconLoad, path, err))
+		}
+		a.lexicons[strings.TrimSuffix(filepath.Base(path), ".tsv")] = lex
+	}
+	return nil
+}
+
+// Score レビューの言語に対応する辞書で感情スコアを計算
+//
+// 対応する辞書がない場合や辞書の語が見つからない場合は false を返す。
+func (a *Analyzer) Score(review models.ReviewData) (float64, bool) {
+	lex, ok := a.lexicons[strings.ToLower(review.Language)]
+	if !ok {
+		return 0, false
+	}
+	return lex.Score(review.Review)
+}
+
+// Annotate 各レビューに感情スコアを設定し、スコアを付与したレビュー数を返す
+func (a *Analyzer) Annotate(reviews []models.ReviewData) int {
+	scored := 0
+	for i := range reviews {
+		score, ok := a.Score(reviews[i])
+		if !ok {
+			reviews[i].SentimentScore = nil
+			continue
+		}
+		score = math.Round(score*1000) / 1000
+		reviews[i].SentimentScore = &score
+		scored++
+	}
+	return scored
+}
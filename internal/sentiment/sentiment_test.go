@@ -0,0 +1,109 @@
+package sentiment
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestAnalyzerScore(t *testing.T) {
+	analyzer, err := NewAnalyzer()
+	if err != nil {
+		t.Fatalf("NewAnalyzer() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		language string
+		review   string
+		wantSign int // 1: 正, -1: 負, 0: スコアなし
+	}{
+		{"English positive", "english", "Great story and beautiful music", 1},
+		{"English negative", "english", "Buggy, boring and overpriced", -1},
+		{"English negation", "english", "This is not fun at all", -1},
+		{"Japanese positive", "japanese", "とても面白い神ゲーでした", 1},
+		{"Japanese negative", "japanese", "バグだらけで最悪", -1},
+		{"Japanese negation", "japanese", "全然面白くない", -1},
+		{"No lexicon hits", "english", "I bought it yesterday", 0},
+		{"Unsupported language", "french", "Excellent jeu", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, ok := analyzer.Score(models.ReviewData{Language: tt.language, Review: tt.review})
+			switch {
+			case tt.wantSign == 0 && ok:
+				t.Errorf("Score(%q) = %v, want no score", tt.review, score)
+			case tt.wantSign > 0 && (!ok || score <= 0):
+				t.Errorf("Score(%q) = %v (ok=%v), want positive", tt.review, score, ok)
+			case tt.wantSign < 0 && (!ok || score >= 0):
+				t.Errorf("Score(%q) = %v (ok=%v), want negative", tt.review, score, ok)
+			}
+			if score < -1 || score > 1 {
+				t.Errorf("Score(%q) = %v, want within [-1, 1]", tt.review, score)
+			}
+		})
+	}
+}
+
+func TestParseLexicon(t *testing.T) {
+	lex, err := ParseLexicon(strings.NewReader("# comment\n\ngood\t2\nBAD\t-2.5\n"))
+	if err != nil {
+		t.Fatalf("ParseLexicon() error = %v", err)
+	}
+	if lex.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", lex.Len())
+	}
+	if lex.entries["bad"] != -2.5 {
+		t.Errorf("entries[bad] = %v, want -2.5", lex.entries["bad"])
+	}
+
+	if _, err := ParseLexicon(strings.NewReader("good 2\n")); err == nil {
+		t.Error("ParseLexicon() with invalid line should return error")
+	}
+}
+
+func TestLoadLexiconDirAndAnnotate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "english.tsv"), []byte("meh\t3\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	analyzer, err := NewAnalyzer()
+	if err != nil {
+		t.Fatalf("NewAnalyzer() error = %v", err)
+	}
+	if err := analyzer.LoadLexiconDir(dir); err != nil {
+		t.Fatalf("LoadLexiconDir() error = %v", err)
+	}
+
+	reviews := []models.ReviewData{
+		{Language: "english", Review: "meh"},
+		{Language: "english", Review: "great"}, // 置き換え後の辞書には存在しない
+	}
+	if scored := analyzer.Annotate(reviews); scored != 1 {
+		t.Errorf("Annotate() = %d, want 1", scored)
+	}
+	if reviews[0].SentimentScore == nil || *reviews[0].SentimentScore <= 0 {
+		t.Errorf("reviews[0].SentimentScore = %v, want positive", reviews[0].SentimentScore)
+	}
+	if reviews[1].SentimentScore != nil {
+		t.Errorf("reviews[1].SentimentScore = %v, want nil", *reviews[1].SentimentScore)
+	}
+}
+
+func TestLoadLexiconDirNoLexicons(t *testing.T) {
+	analyzer, err := NewAnalyzer()
+	if err != nil {
+		t.Fatalf("NewAnalyzer() error = %v", err)
+	}
+	for _, dir := range []string{t.TempDir(), filepath.Join(t.TempDir(), "missing")} {
+		if err := analyzer.LoadLexiconDir(dir); !errors.Is(err, ErrNoLexicons) {
+			t.Errorf("LoadLexiconDir(%q) error = %v, want ErrNoLexicons", dir, err)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package stats
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// チャートの文字セット
+const (
+	ChartStyleUnicode = "unicode"
+	ChartStyleASCII   = "ascii"
+)
+
+// maxChartLanguages 言語別チャートに表示する最大言語数
+const maxChartLanguages = 10
+
+var (
+	unicodeSparks = []rune("▁▂▃▄▅▆▇█")
+	asciiSparks   = []rune("_.-:=+*#")
+	// unicodeBarParts 1/8単位の横棒の端
+	unicodeBarParts = []rune("▏▎▍▌▋▊▉")
+)
+
+// ChartOptions チャート表示のオプション
+type ChartOptions struct {
+	Width int    // 出力幅（桁数）
+	Style string // 文字セット (unicode, ascii)
+}
+
+// Sparkline 値の列をスパークライン文字列に変換
+//
+// 値の数が width を超える場合は隣接する値を合計して width 個にまとめる。
+func Sparkline(values []int, width int, style string) string {
+	if len(values) == 0 || width <= 0 {
+		return ""
+	}
+	values = resample(values, width)
+
+	sparks := unicodeSparks
+	if style == ChartStyleASCII {
+		sparks = asciiSparks
+	}
+
+	maxValue := 0
+	for _, v := range values {
+		if v > maxValue {
+			maxValue = v
+		}
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		if maxValue == 0 {
+			sb.WriteRune(sparks[0])
+			continue
+		}
+		level := int(math.Round(float64(v) / float64(maxValue) * float64(len(sparks)-1)))
+		sb.WriteRune(sparks[level])
+	}
+	return sb.String()
+}
+
+// resample 値の列を最大 width 個の区間に合計してまとめる
+func resample(values []int, width int) []int {
+	if len(values) <= width {
+		return values
+	}
+	result := make([]int, width)
+	for i, v := range values {
+		result[i*width/len(values)] += v
+	}
+	return result
+}
+
+// Bar value/maxValue の割合を width 桁の横棒で表す
+func Bar(value, maxValue float64, width int, style string) string {
+	if maxValue <= 0 || width <= 0 || value <= 0 {
+		return ""
+	}
+	ratio := math.Min(value/maxValue, 1)
+
+	if style == ChartStyleASCII {
+		return strings.Repeat("#", int(math.Round(ratio*float64(width))))
+	}
+
+	eighths := int(math.Round(ratio * float64(width) * 8))
+	bar := strings.Repeat("█", eighths/8)
+	if rem := eighths % 8; rem > 0 {
+		bar += string(unicodeBarParts[rem-1])
+	}
+	return bar
+}
+
+// barRow ラベル・横棒・値を1行に整形
+func barRow(label string, value, maxValue float64, valueText string, labelWidth, barWidth int, style string) string {
+	padding := labelWidth - utf8.RuneCountInString(label)
+	if padding < 0 {
+		padding = 0
+	}
+	bar := Bar(value, maxValue, barWidth, style)
+	barPadding := barWidth - utf8.RuneCountInString(bar)
+	if barPadding < 0 {
+		barPadding = 0
+	}
+	return fmt.Sprintf("  %s%s %s%s %s",
+		label, strings.Repeat(" ", padding), bar, strings.Repeat(" ", barPadding), valueText)
+}
+
+// PrintCharts 統計をチャートで表示
+func PrintCharts(w io.Writer, rs ReviewStats, opts ChartOptions) {
+	if rs.TotalReviews == 0 {
+		return
+	}
+	width := opts.Width
+	if width < 40 {
+		width = 40
+	}
+
+	// レビュー数の推移
+	if len(rs.Timeline.Points) > 1 {
+		values := make([]int, len(rs.Timeline.Points))
+		maxValue := 0
+		for i, p := range rs.Timeline.Points {
+			values[i] = p.Total
+			if p.Total > maxValue {
+				maxValue = p.Total
+			}
+		}
+		first := rs.Timeline.Points[0].Start
+		last := rs.Timeline.Points[len(rs.Timeline.Points)-1].Start
+
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgChartTimeline, rs.Timeline.Period))
+		fmt.Fprintln(w, "  "+Sparkline(values, width-4, opts.Style))
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgChartTimelineRange, first, last, maxValue))
+	}
+
+	// 言語別のシェアと肯定的割合
+	languages := rs.Languages
+	if len(languages) > maxChartLanguages {
+		languages = languages[:maxChartLanguages]
+	}
+	labelWidth := 0
+	for _, ls := range languages {
+		if n := utf8.RuneCountInString(ls.Language); n > labelWidth {
+			labelWidth = n
+		}
+	}
+	barWidth := width - labelWidth - 14
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgChartLanguageShare))
+	for _, ls := range languages {
+		fmt.Fprintln(w, barRow(ls.Language, ls.Share, languages[0].Share,
+			fmt.Sprintf("%5.1f%%", ls.Share), labelWidth, barWidth, opts.Style))
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgChartPositiveRatio))
+	for _, ls := range languages {
+		fmt.Fprintln(w, barRow(ls.Language, ls.PositiveRatio, 100,
+			fmt.Sprintf("%5.1f%%", ls.PositiveRatio), labelWidth, barWidth, opts.Style))
+	}
+
+	// プレイ時間のヒストグラム
+	maxBucket := 0
+	labelWidth = 0
+	for _, b := range rs.Playtime.Buckets {
+		if b.Total > maxBucket {
+			maxBucket = b.Total
+		}
+		if n := utf8.RuneCountInString(b.Label); n > labelWidth {
+			labelWidth = n
+		}
+	}
+	barWidth = width - labelWidth - 14
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgChartPlaytime))
+	for _, b := range rs.Playtime.Buckets {
+		fmt.Fprintln(w, barRow(b.Label, float64(b.Total), float64(maxBucket),
+			fmt.Sprintf("%6d", b.Total), labelWidth, barWidth, opts.Style))
+	}
+}
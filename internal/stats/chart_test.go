@@ -0,0 +1,94 @@
+package stats
+
+import (
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		width    int
+		style    string
+		expected string
+	}{
+		{"Unicode", []int{0, 4, 8}, 10, ChartStyleUnicode, "▁▅█"},
+		{"ASCII", []int{0, 7}, 10, ChartStyleASCII, "_#"},
+		{"All zero", []int{0, 0}, 10, ChartStyleUnicode, "▁▁"},
+		{"Resampled", []int{1, 1, 2, 2}, 2, ChartStyleUnicode, "▅█"},
+		{"Empty", nil, 10, ChartStyleUnicode, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Sparkline(tt.values, tt.width, tt.style)
+			if result != tt.expected {
+				t.Errorf("Sparkline(%v, %d) = %q, want %q", tt.values, tt.width, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBar(t *testing.T) {
+	if bar := Bar(50, 100, 10, ChartStyleASCII); bar != "#####" {
+		t.Errorf("Bar(ascii) = %q, want %q", bar, "#####")
+	}
+	if bar := Bar(100, 100, 4, ChartStyleUnicode); bar != "████" {
+		t.Errorf("Bar(full) = %q, want %q", bar, "████")
+	}
+	if bar := Bar(1, 16, 2, ChartStyleUnicode); bar != "▏" {
+		t.Errorf("Bar(1/16) = %q, want %q", bar, "▏")
+	}
+	if bar := Bar(0, 100, 10, ChartStyleUnicode); bar != "" {
+		t.Errorf("Bar(0) = %q, want empty", bar)
+	}
+}
+
+func TestComputeTimeline(t *testing.T) {
+	day := func(s string) int64 {
+		tm, _ := time.Parse("2006-01-02", s)
+		return tm.Add(12 * time.Hour).Unix()
+	}
+	reviews := []models.ReviewData{
+		{TimestampCreated: day("2024-03-01"), VotedUp: true},
+		{TimestampCreated: day("2024-03-01"), VotedUp: false},
+		{TimestampCreated: day("2024-03-04"), VotedUp: true},
+		{TimestampCreated: 0},
+	}
+
+	tl := ComputeTimeline(reviews)
+
+	if tl.Period != PeriodDay {
+		t.Errorf("Period = %q, want %q", tl.Period, PeriodDay)
+	}
+	if len(tl.Points) != 4 {
+		t.Fatalf("len(Points) = %d, want 4 (gaps filled)", len(tl.Points))
+	}
+	if tl.Points[0].Start != "2024-03-01" || tl.Points[0].Total != 2 || tl.Points[0].Positive != 1 {
+		t.Errorf("Points[0] = %+v", tl.Points[0])
+	}
+	if tl.Points[3].Total != 1 || tl.Points[1].Total != 0 {
+		t.Errorf("Points = %+v", tl.Points)
+	}
+}
+
+func TestPrintCharts(t *testing.T) {
+	var buf strings.Builder
+	rs := Compute(sampleReviews(), "Test Game", DefaultOptions())
+	PrintCharts(&buf, rs, ChartOptions{Width: 60, Style: ChartStyleASCII})
+
+	output := buf.String()
+	if !strings.Contains(output, "japanese") || !strings.Contains(output, "#") {
+		t.Errorf("PrintCharts output missing language bars: %q", output)
+	}
+	for _, line := range strings.Split(output, "\n") {
+		if utf8.RuneCountInString(line) > 60 {
+			t.Errorf("line exceeds width: %q", line)
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package stats
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// compareLanguages 比較表の言語構成に表示する言語数
+const compareLanguages = 3
+
+// GameComparison ゲーム比較に使う1ゲーム分の指標
+type GameComparison struct {
+	GameName              string          `json:"game_name"`
+	AppID                 string          `json:"app_id"`
+	TotalReviews          int             `json:"total_reviews"`
+	PositiveRatio         float64         `json:"positive_ratio"`          // 肯定的レビューの割合 (%)
+	Languages             []LanguageStats `json:"languages"`               // レビュー数の多い順
+	MedianPlaytimeHours   float64         `json:"median_playtime_hours"`   // レビュー時点プレイ時間の中央値
+	EarlyAccessShare      float64         `json:"early_access_share"`      // 早期アクセス中に書かれたレビューの割合 (%)
+	DeveloperResponseRate float64         `json:"developer_response_rate"` // 開発者が返信したレビューの割合 (%)
+	ReviewScoreDesc       string          `json:"review_score_desc"`       // Steam公式の評価 (取得できない場合は空)
+	SteamTotalReviews     int             `json:"steam_total_reviews"`     // Steam公式の総レビュー数 (取得できない場合は0)
+}
+
+// ComputeGameComparison レビューからゲーム比較用の指標を計算
+//
+// summary には Steam API の集計情報を渡す。保存済みファイルなどで取得できない場合は nil でよい。
+func ComputeGameComparison(reviews []models.ReviewData, gameName, appID string, summary *models.QuerySummary) GameComparison {
+	rs := Compute(reviews, gameName, DefaultOptions())
+	gc := GameComparison{
+		GameName:              gameName,
+		AppID:                 appID,
+		TotalReviews:          rs.TotalReviews,
+		PositiveRatio:         rs.PositiveRatio,
+		Languages:             rs.Languages,
+		DeveloperResponseRate: rs.DeveloperResponse.Overall.Rate,
+	}
+
+	var hours []float64
+	earlyAccess := 0
+	for _, review := range reviews {
+		hours = append(hours, float64(review.Author.PlaytimeAtReview)/60)
+		if review.WrittenDuringEA {
+			earlyAccess++
+		}
+	}
+	gc.MedianPlaytimeHours = median(hours)
+	gc.EarlyAccessShare = percent(earlyAccess, len(reviews))
+
+	if summary != nil {
+		gc.ReviewScoreDesc = summary.ReviewScoreDesc
+		gc.SteamTotalReviews = summary.TotalReviews
+	}
+
+	return gc
+}
+
+// languageMix 言語構成を "japanese 62%, english 30%" の形式で返す
+func languageMix(languages []LanguageStats, limit int) string {
+	if len(languages) > limit {
+		languages = languages[:limit]
+	}
+	parts := make([]string, len(languages))
+	for i, ls := range languages {
+		parts[i] = fmt.Sprintf("%s %.0f%%", ls.Language, ls.Share)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// PrintComparison 複数ゲームの指標を横並びの表で表示
+func PrintComparison(w io.Writer, games []GameComparison) {
+	if len(games) == 0 {
+		return
+	}
+
+	rows := [][]string{
+		{i18n.T(i18n.MsgCompareGame)},
+		{i18n.T(i18n.MsgCompareAppID)},
+		{i18n.T(i18n.MsgCompareTotalReviews)},
+		{i18n.T(i18n.MsgComparePositiveRatio)},
+		{i18n.T(i18n.MsgCompareLanguages)},
+		{i18n.T(i18n.MsgCompareMedianPlaytime)},
+		{i18n.T(i18n.MsgCompareEarlyAccess)},
+		{i18n.T(i18n.MsgCompareDevResponse)},
+		{i18n.T(i18n.MsgCompareSteamScore)},
+	}
+	for _, gc := range games {
+		appID := gc.AppID
+		if appID == "" {
+			appID = "-"
+		}
+		steamScore := "-"
+		if gc.ReviewScoreDesc != "" {
+			steamScore = i18n.Tf(i18n.MsgCompareSteamScoreValue, gc.ReviewScoreDesc, gc.SteamTotalReviews)
+		}
+		values := []string{
+			gc.GameName,
+			appID,
+			strconv.Itoa(gc.TotalReviews),
+			fmt.Sprintf("%.1f%%", gc.PositiveRatio),
+			languageMix(gc.Languages, compareLanguages),
+			fmt.Sprintf("%.1fh", gc.MedianPlaytimeHours),
+			fmt.Sprintf("%.1f%%", gc.EarlyAccessShare),
+			fmt.Sprintf("%.1f%%", gc.DeveloperResponseRate),
+			steamScore,
+		}
+		for i := range rows {
+			rows[i] = append(rows[i], values[i])
+		}
+	}
+
+	var sb strings.Builder
+	tw := tabwriter.NewWriter(&sb, 0, 0, 2, ' ', 0)
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgCompareTitle))
+	for _, line := range strings.Split(strings.TrimRight(sb.String(), "\n"), "\n") {
+		fmt.Fprintln(w, line)
+	}
+}
+
+// WriteComparisonCSV 複数ゲームの指標を1ゲーム1行のCSV形式で書き込む
+//
+// 言語構成は "japanese:62.0;english:30.0" の形式で全言語を出力する。
+func WriteComparisonCSV(w io.Writer, games []GameComparison) error {
+	writer := csv.NewWriter(w)
+	header := []string{
+		"app_id", "game_name", "total_reviews", "positive_ratio", "languages",
+		"median_playtime_hours", "early_access_share", "developer_response_rate",
+		"review_score_desc", "steam_total_reviews",
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, gc := range games {
+		languages := make([]string, len(gc.Languages))
+		for i, ls := range gc.Languages {
+			languages[i] = fmt.Sprintf("%s:%.1f", ls.Language, ls.Share)
+		}
+		record := []string{
+			gc.AppID,
+			gc.GameName,
+			strconv.Itoa(gc.TotalReviews),
+			strconv.FormatFloat(gc.PositiveRatio, 'f', 4, 64),
+			strings.Join(languages, ";"),
+			strconv.FormatFloat(gc.MedianPlaytimeHours, 'f', 4, 64),
+			strconv.FormatFloat(gc.EarlyAccessShare, 'f', 4, 64),
+			strconv.FormatFloat(gc.DeveloperResponseRate, 'f', 4, 64),
+			gc.ReviewScoreDesc,
+			strconv.Itoa(gc.SteamTotalReviews),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
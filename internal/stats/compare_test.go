@@ -0,0 +1,87 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestComputeGameComparison(t *testing.T) {
+	reviews := []models.ReviewData{
+		{Language: "english", VotedUp: true, WrittenDuringEA: true, Author: models.AuthorData{PlaytimeAtReview: 60}},
+		{Language: "english", VotedUp: true, DeveloperResponse: "thanks", Author: models.AuthorData{PlaytimeAtReview: 180}},
+		{Language: "japanese", VotedUp: false, Author: models.AuthorData{PlaytimeAtReview: 600}},
+		{Language: "english", VotedUp: false, WrittenDuringEA: true, Author: models.AuthorData{PlaytimeAtReview: 120}},
+	}
+	summary := &models.QuerySummary{ReviewScoreDesc: "Mostly Positive", TotalReviews: 1234}
+
+	gc := ComputeGameComparison(reviews, "Test Game", "440", summary)
+
+	if gc.TotalReviews != 4 || gc.PositiveRatio != 50 {
+		t.Errorf("total/positive = %d/%.1f, want 4/50.0", gc.TotalReviews, gc.PositiveRatio)
+	}
+	if gc.MedianPlaytimeHours != 2.5 {
+		t.Errorf("MedianPlaytimeHours = %.2f, want 2.50", gc.MedianPlaytimeHours)
+	}
+	if gc.EarlyAccessShare != 50 {
+		t.Errorf("EarlyAccessShare = %.1f, want 50.0", gc.EarlyAccessShare)
+	}
+	if gc.DeveloperResponseRate != 25 {
+		t.Errorf("DeveloperResponseRate = %.1f, want 25.0", gc.DeveloperResponseRate)
+	}
+	if gc.ReviewScoreDesc != "Mostly Positive" || gc.SteamTotalReviews != 1234 {
+		t.Errorf("summary = %q/%d, want Mostly Positive/1234", gc.ReviewScoreDesc, gc.SteamTotalReviews)
+	}
+	if mix := languageMix(gc.Languages, 1); mix != "english 75%" {
+		t.Errorf("languageMix = %q, want %q", mix, "english 75%")
+	}
+
+	if gc := ComputeGameComparison(reviews, "Saved", "", nil); gc.ReviewScoreDesc != "" {
+		t.Errorf("ReviewScoreDesc without summary = %q, want empty", gc.ReviewScoreDesc)
+	}
+}
+
+func TestPrintComparison(t *testing.T) {
+	games := []GameComparison{
+		ComputeGameComparison(sampleReviews(), "Game A", "1", nil),
+		ComputeGameComparison(sampleReviews()[:2], "Game B", "2", &models.QuerySummary{ReviewScoreDesc: "Positive"}),
+	}
+
+	var buf strings.Builder
+	PrintComparison(&buf, games)
+	output := buf.String()
+
+	for _, want := range []string{"Game A", "Game B", "Positive"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output does not contain %q:\n%s", want, output)
+		}
+	}
+}
+
+func TestWriteComparisonCSV(t *testing.T) {
+	games := []GameComparison{
+		ComputeGameComparison(sampleReviews(), "Game A", "1", nil),
+		ComputeGameComparison(sampleReviews(), "Game B", "2", nil),
+	}
+
+	var buf bytes.Buffer
+	if err := WriteComparisonCSV(&buf, games); err != nil {
+		t.Fatalf("WriteComparisonCSV() error = %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("invalid CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("len(records) = %d, want 3", len(records))
+	}
+	if records[1][0] != "1" || records[2][1] != "Game B" {
+		t.Errorf("unexpected records: %v", records)
+	}
+	if records[1][4] != "japanese:40.0;english:20.0;schinese:20.0;unknown:20.0" {
+		t.Errorf("languages = %q", records[1][4])
+	}
+}
@@ -0,0 +1,174 @@
+package stats
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// ResponseRate グループごとの開発者返信率
+type ResponseRate struct {
+	Group     string  `json:"group"`     // グループ名 (言語名など)
+	Total     int     `json:"total"`     // レビュー数
+	Responded int     `json:"responded"` // 開発者が返信したレビュー数
+	Rate      float64 `json:"rate"`      // 返信率 (%)
+}
+
+// LatencyBucket 返信までの時間の区分
+type LatencyBucket struct {
+	Label    string `json:"label"`     // 表示用ラベル (例: "1-7d")
+	MaxHours int    `json:"max_hours"` // 上限（時間、この値を含まない。0は上限なし）
+	Count    int    `json:"count"`     // 該当する返信数
+}
+
+// DeveloperResponseStats 開発者返信の統計
+type DeveloperResponseStats struct {
+	Overall            ResponseRate    `json:"overall"`
+	Positive           ResponseRate    `json:"positive"`
+	Negative           ResponseRate    `json:"negative"`
+	ByLanguage         []ResponseRate  `json:"by_language"`          // レビュー数の多い順
+	Latency            []LatencyBucket `json:"latency"`              // 返信までの時間の分布
+	MedianLatencyHours float64         `json:"median_latency_hours"` // 返信までの時間の中央値
+	UnansweredNegative int             `json:"unanswered_negative"`  // 未返信の否定的レビュー数
+}
+
+// hasDeveloperResponse 開発者の返信があるか
+func hasDeveloperResponse(review models.ReviewData) bool {
+	return strings.TrimSpace(review.DeveloperResponse) != ""
+}
+
+// newLatencyBuckets 返信時間の区分を作成（1日未満, 1-7日, 7-30日, 30日以上）
+func newLatencyBuckets() []LatencyBucket {
+	return []LatencyBucket{
+		{Label: "<1d", MaxHours: 24},
+		{Label: "1-7d", MaxHours: 24 * 7},
+		{Label: "7-30d", MaxHours: 24 * 30},
+		{Label: "30d+"},
+	}
+}
+
+// ComputeDeveloperResponseStats 開発者返信の統計を計算
+func ComputeDeveloperResponseStats(reviews []models.ReviewData) DeveloperResponseStats {
+	ds := DeveloperResponseStats{
+		Latency: newLatencyBuckets(),
+	}
+	byLanguage := make(map[string]*ResponseRate)
+	var latencies []float64
+
+	for _, review := range reviews {
+		responded := hasDeveloperResponse(review)
+
+		lang := review.Language
+		if lang == "" {
+			lang = "unknown"
+		}
+		lr, ok := byLanguage[lang]
+		if !ok {
+			lr = &ResponseRate{Group: lang}
+			byLanguage[lang] = lr
+		}
+
+		groups := []*ResponseRate{&ds.Overall, lr}
+		if review.VotedUp {
+			groups = append(groups, &ds.Positive)
+		} else {
+			groups = append(groups, &ds.Negative)
+		}
+		for _, g := range groups {
+			g.Total++
+			if responded {
+				g.Responded++
+			}
+		}
+
+		if !responded {
+			if !review.VotedUp {
+				ds.UnansweredNegative++
+			}
+			continue
+		}
+
+		if review.TimestampDevResponse > 0 && review.TimestampCreated > 0 {
+			hours := float64(review.TimestampDevResponse-review.TimestampCreated) / 3600
+			if hours < 0 {
+				hours = 0
+			}
+			latencies = append(latencies, hours)
+			for i := range ds.Latency {
+				if ds.Latency[i].MaxHours == 0 || hours < float64(ds.Latency[i].MaxHours) {
+					ds.Latency[i].Count++
+					break
+				}
+			}
+		}
+	}
+
+	ds.Overall.Group = "all"
+	ds.Positive.Group = "positive"
+	ds.Negative.Group = "negative"
+	for _, r := range []*ResponseRate{&ds.Overall, &ds.Positive, &ds.Negative} {
+		r.Rate = percent(r.Responded, r.Total)
+	}
+
+	for _, lr := range byLanguage {
+		lr.Rate = percent(lr.Responded, lr.Total)
+		ds.ByLanguage = append(ds.ByLanguage, *lr)
+	}
+	sort.Slice(ds.ByLanguage, func(i, j int) bool {
+		if ds.ByLanguage[i].Total != ds.ByLanguage[j].Total {
+			return ds.ByLanguage[i].Total > ds.ByLanguage[j].Total
+		}
+		return ds.ByLanguage[i].Group < ds.ByLanguage[j].Group
+	})
+
+	ds.MedianLatencyHours = median(latencies)
+
+	return ds
+}
+
+// UnansweredNegativeReviews 開発者が返信していない否定的レビューを有用性の高い順に返す
+func UnansweredNegativeReviews(reviews []models.ReviewData) []models.ReviewData {
+	var unanswered []models.ReviewData
+	for _, review := range reviews {
+		if !review.VotedUp && !hasDeveloperResponse(review) {
+			unanswered = append(unanswered, review)
+		}
+	}
+
+	sort.SliceStable(unanswered, func(i, j int) bool {
+		if unanswered[i].VotesUp != unanswered[j].VotesUp {
+			return unanswered[i].VotesUp > unanswered[j].VotesUp
+		}
+		if unanswered[i].WeightedScore != unanswered[j].WeightedScore {
+			return unanswered[i].WeightedScore > unanswered[j].WeightedScore
+		}
+		return unanswered[i].TimestampCreated > unanswered[j].TimestampCreated
+	})
+
+	return unanswered
+}
+
+// printDeveloperResponseStats 開発者返信の統計を表示
+func printDeveloperResponseStats(w io.Writer, ds DeveloperResponseStats) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgStatsDevResponseTitle))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsDevResponseRate, ds.Overall.Responded, ds.Overall.Total, ds.Overall.Rate))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsDevResponsePositive, ds.Positive.Responded, ds.Positive.Total, ds.Positive.Rate))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsDevResponseNegative, ds.Negative.Responded, ds.Negative.Total, ds.Negative.Rate))
+	for _, lr := range ds.ByLanguage {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsDevResponseLanguage, config.LanguageLabel(lr.Group), lr.Responded, lr.Total, lr.Rate))
+	}
+
+	if ds.Overall.Responded > 0 {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsDevResponseLatency, ds.MedianLatencyHours))
+		for _, b := range ds.Latency {
+			fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsDevResponseLatencyBucket, b.Label, b.Count))
+		}
+	}
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsDevResponseUnanswered, ds.UnansweredNegative))
+}
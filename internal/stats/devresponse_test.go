@@ -0,0 +1,64 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestComputeDeveloperResponseStats(t *testing.T) {
+	const created = int64(1700000000)
+	reviews := []models.ReviewData{
+		{Language: "japanese", VotedUp: false, TimestampCreated: created, DeveloperResponse: "Thanks", TimestampDevResponse: created + 3600},
+		{Language: "japanese", VotedUp: false, TimestampCreated: created},
+		{Language: "english", VotedUp: true, TimestampCreated: created, DeveloperResponse: "Thanks", TimestampDevResponse: created + 3*24*3600},
+		{Language: "english", VotedUp: true, TimestampCreated: created},
+		{Language: "english", VotedUp: false, TimestampCreated: created, DeveloperResponse: "  "},
+	}
+
+	ds := ComputeDeveloperResponseStats(reviews)
+
+	if ds.Overall.Total != 5 || ds.Overall.Responded != 2 {
+		t.Errorf("Overall = %d/%d, want 2/5", ds.Overall.Responded, ds.Overall.Total)
+	}
+	if ds.Negative.Total != 3 || ds.Negative.Responded != 1 {
+		t.Errorf("Negative = %d/%d, want 1/3", ds.Negative.Responded, ds.Negative.Total)
+	}
+	if ds.Positive.Rate != 50 {
+		t.Errorf("Positive.Rate = %v, want 50", ds.Positive.Rate)
+	}
+	if len(ds.ByLanguage) != 2 || ds.ByLanguage[0].Group != "english" {
+		t.Fatalf("ByLanguage = %+v, want english first", ds.ByLanguage)
+	}
+	if ds.UnansweredNegative != 2 {
+		t.Errorf("UnansweredNegative = %d, want 2", ds.UnansweredNegative)
+	}
+	if ds.Latency[0].Count != 1 || ds.Latency[1].Count != 1 {
+		t.Errorf("Latency = %+v, want one <1d and one 1-7d", ds.Latency)
+	}
+	if ds.MedianLatencyHours != 36.5 {
+		t.Errorf("MedianLatencyHours = %v, want 36.5", ds.MedianLatencyHours)
+	}
+}
+
+func TestUnansweredNegativeReviews(t *testing.T) {
+	reviews := []models.ReviewData{
+		{RecommendationID: "1", VotedUp: false, VotesUp: 3},
+		{RecommendationID: "2", VotedUp: true, VotesUp: 100},
+		{RecommendationID: "3", VotedUp: false, VotesUp: 10},
+		{RecommendationID: "4", VotedUp: false, VotesUp: 50, DeveloperResponse: "Fixed"},
+		{RecommendationID: "5", VotedUp: false, VotesUp: 10, WeightedScore: 0.9},
+	}
+
+	result := UnansweredNegativeReviews(reviews)
+
+	expected := []string{"5", "3", "1"}
+	if len(result) != len(expected) {
+		t.Fatalf("len(result) = %d, want %d", len(result), len(expected))
+	}
+	for i, id := range expected {
+		if result[i].RecommendationID != id {
+			t.Errorf("result[%d].RecommendationID = %q, want %q", i, result[i].RecommendationID, id)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package stats
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// DefaultTopHelpful 表示する最も有用なレビューのデフォルト件数
+const DefaultTopHelpful = 3
+
+// wilsonZ Wilsonスコア区間の信頼水準95%に対応するz値
+const wilsonZ = 1.96
+
+// ConfidenceInterval 肯定的レビュー割合の信頼区間
+type ConfidenceInterval struct {
+	Group    string  `json:"group"`    // グループ名 (言語名など)
+	Total    int     `json:"total"`    // レビュー数
+	Positive int     `json:"positive"` // 肯定的レビュー数
+	Lower    float64 `json:"lower"`    // 下限 (%)
+	Upper    float64 `json:"upper"`    // 上限 (%)
+}
+
+// HelpfulnessStats 有用性で重み付けした統計
+type HelpfulnessStats struct {
+	VoteWeightedPositive  float64              `json:"vote_weighted_positive"`  // 「役に立った」票数+1で重み付けした肯定的割合 (%)
+	ScoreWeightedPositive float64              `json:"score_weighted_positive"` // weighted_vote_scoreで重み付けした肯定的割合 (%)
+	Overall               ConfidenceInterval   `json:"overall"`                 // 全体の95%信頼区間
+	ByLanguage            []ConfidenceInterval `json:"by_language"`             // 言語別の95%信頼区間（レビュー数の多い順）
+	TopPositive           []models.ReviewData  `json:"top_positive"`            // 最も有用な肯定的レビュー
+	TopNegative           []models.ReviewData  `json:"top_negative"`            // 最も有用な否定的レビュー
+}
+
+// WilsonInterval 肯定的割合のWilsonスコア区間を計算（zは信頼水準に対応する値、戻り値は0〜1）
+func WilsonInterval(positive, total int, z float64) (lower, upper float64) {
+	if total == 0 {
+		return 0, 0
+	}
+	n := float64(total)
+	p := float64(positive) / n
+	z2 := z * z
+	center := p + z2/(2*n)
+	margin := z * math.Sqrt(p*(1-p)/n+z2/(4*n*n))
+	denom := 1 + z2/n
+	return math.Max(0, (center-margin)/denom), math.Min(1, (center+margin)/denom)
+}
+
+// newConfidenceInterval 95%信頼区間を計算してConfidenceIntervalを作成
+func newConfidenceInterval(group string, positive, total int) ConfidenceInterval {
+	lower, upper := WilsonInterval(positive, total, wilsonZ)
+	return ConfidenceInterval{
+		Group:    group,
+		Total:    total,
+		Positive: positive,
+		Lower:    lower * 100,
+		Upper:    upper * 100,
+	}
+}
+
+// ComputeHelpfulnessStats 有用性で重み付けした統計を計算
+func ComputeHelpfulnessStats(reviews []models.ReviewData, topN int) HelpfulnessStats {
+	var hs HelpfulnessStats
+	var voteWeight, votePositive, scoreWeight, scorePositive float64
+	positive := 0
+	languageCounts := make(map[string]int)
+	languagePositive := make(map[string]int)
+	var positives, negatives []models.ReviewData
+
+	for _, review := range reviews {
+		vw := float64(review.VotesUp + 1)
+		voteWeight += vw
+		scoreWeight += review.WeightedScore
+
+		lang := review.Language
+		if lang == "" {
+			lang = "unknown"
+		}
+		languageCounts[lang]++
+
+		if review.VotedUp {
+			positive++
+			votePositive += vw
+			scorePositive += review.WeightedScore
+			languagePositive[lang]++
+			positives = append(positives, review)
+		} else {
+			negatives = append(negatives, review)
+		}
+	}
+
+	if voteWeight > 0 {
+		hs.VoteWeightedPositive = votePositive / voteWeight * 100
+	}
+	if scoreWeight > 0 {
+		hs.ScoreWeightedPositive = scorePositive / scoreWeight * 100
+	}
+
+	hs.Overall = newConfidenceInterval("all", positive, len(reviews))
+	for lang, count := range languageCounts {
+		hs.ByLanguage = append(hs.ByLanguage, newConfidenceInterval(lang, languagePositive[lang], count))
+	}
+	sort.Slice(hs.ByLanguage, func(i, j int) bool {
+		if hs.ByLanguage[i].Total != hs.ByLanguage[j].Total {
+			return hs.ByLanguage[i].Total > hs.ByLanguage[j].Total
+		}
+		return hs.ByLanguage[i].Group < hs.ByLanguage[j].Group
+	})
+
+	hs.TopPositive = mostHelpful(positives, topN)
+	hs.TopNegative = mostHelpful(negatives, topN)
+
+	return hs
+}
+
+// mostHelpful 「役に立った」票数の多い順に上位n件を返す（n が0以下の場合は nil）
+func mostHelpful(reviews []models.ReviewData, n int) []models.ReviewData {
+	if n <= 0 {
+		return nil
+	}
+	sort.SliceStable(reviews, func(i, j int) bool {
+		if reviews[i].VotesUp != reviews[j].VotesUp {
+			return reviews[i].VotesUp > reviews[j].VotesUp
+		}
+		return reviews[i].WeightedScore > reviews[j].WeightedScore
+	})
+	if len(reviews) > n {
+		reviews = reviews[:n]
+	}
+	return reviews
+}
+
+// printHelpfulnessStats 有用性で重み付けした統計を表示
+func printHelpfulnessStats(w io.Writer, hs HelpfulnessStats) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgStatsHelpfulnessTitle))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsVoteWeighted, hs.VoteWeightedPositive))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsScoreWeighted, hs.ScoreWeightedPositive))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsWilsonOverall, hs.Overall.Lower, hs.Overall.Upper))
+	for _, ci := range hs.ByLanguage {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsWilsonLanguage, config.LanguageLabel(ci.Group), ci.Total, ci.Lower, ci.Upper))
+	}
+
+	sections := []struct {
+		title   string
+		reviews []models.ReviewData
+	}{
+		{i18n.T(i18n.MsgStatsTopPositive), hs.TopPositive},
+		{i18n.T(i18n.MsgStatsTopNegative), hs.TopNegative},
+	}
+	for _, section := range sections {
+		if len(section.reviews) == 0 {
+			continue
+		}
+		fmt.Fprintln(w, section.title)
+		for _, review := range section.reviews {
+			fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsHelpfulItem,
+				review.RecommendationID, review.VotesUp, review.Language, snippet(review.Review, 60)))
+		}
+	}
+}
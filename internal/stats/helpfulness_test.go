@@ -0,0 +1,94 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestWilsonInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		positive int
+		total    int
+		lower    float64
+		upper    float64
+	}{
+		{"No reviews", 0, 0, 0, 0},
+		{"Half positive", 50, 100, 0.4038, 0.5962},
+		{"All positive small sample", 5, 5, 0.5655, 1},
+		{"All negative", 0, 10, 0, 0.2775},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lower, upper := WilsonInterval(tt.positive, tt.total, wilsonZ)
+			if math.Abs(lower-tt.lower) > 0.0001 || math.Abs(upper-tt.upper) > 0.0001 {
+				t.Errorf("WilsonInterval(%d, %d) = (%.4f, %.4f), want (%.4f, %.4f)",
+					tt.positive, tt.total, lower, upper, tt.lower, tt.upper)
+			}
+		})
+	}
+}
+
+func TestComputeHelpfulnessStats(t *testing.T) {
+	reviews := []models.ReviewData{
+		{RecommendationID: "1", Language: "english", VotedUp: true, VotesUp: 9, WeightedScore: 0.9},
+		{RecommendationID: "2", Language: "english", VotedUp: false, VotesUp: 0, WeightedScore: 0.3},
+		{RecommendationID: "3", Language: "japanese", VotedUp: false, VotesUp: 4, WeightedScore: 0.6},
+		{RecommendationID: "4", Language: "english", VotedUp: true, VotesUp: 0},
+	}
+
+	hs := ComputeHelpfulnessStats(reviews, 1)
+
+	// 重み: 10, 1, 5, 1 → 肯定的 11 / 17
+	if math.Abs(hs.VoteWeightedPositive-11.0/17.0*100) > 0.0001 {
+		t.Errorf("VoteWeightedPositive = %v, want %v", hs.VoteWeightedPositive, 11.0/17.0*100)
+	}
+	if math.Abs(hs.ScoreWeightedPositive-50) > 0.0001 {
+		t.Errorf("ScoreWeightedPositive = %v, want 50", hs.ScoreWeightedPositive)
+	}
+	if len(hs.ByLanguage) != 2 || hs.ByLanguage[0].Group != "english" || hs.ByLanguage[0].Total != 3 {
+		t.Errorf("ByLanguage = %+v, want english (3) first", hs.ByLanguage)
+	}
+	if len(hs.TopPositive) != 1 || hs.TopPositive[0].RecommendationID != "1" {
+		t.Errorf("TopPositive = %+v, want [1]", hs.TopPositive)
+	}
+	if len(hs.TopNegative) != 1 || hs.TopNegative[0].RecommendationID != "3" {
+		t.Errorf("TopNegative = %+v, want [3]", hs.TopNegative)
+	}
+}
+
+func TestMostHelpful(t *testing.T) {
+	reviews := []models.ReviewData{
+		{RecommendationID: "1", VotesUp: 1},
+		{RecommendationID: "2", VotesUp: 5},
+		{RecommendationID: "3", VotesUp: 3},
+	}
+
+	tests := []struct {
+		name string
+		n    int
+		want []string
+	}{
+		{"Top two", 2, []string{"2", "3"}},
+		{"More than available", 5, []string{"2", "3", "1"}},
+		{"Zero", 0, nil},
+		{"Negative", -1, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := mostHelpful(append([]models.ReviewData(nil), reviews...), tt.n)
+			if len(got) != len(tt.want) {
+				t.Fatalf("mostHelpful(n=%d) returned %d reviews, want %d", tt.n, len(got), len(tt.want))
+			}
+			for i, id := range tt.want {
+				if got[i].RecommendationID != id {
+					t.Errorf("mostHelpful(n=%d)[%d] = %s, want %s", tt.n, i, got[i].RecommendationID, id)
+				}
+			}
+		})
+	}
+}
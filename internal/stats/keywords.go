@@ -0,0 +1,218 @@
+package stats
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/internal/text"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// KeywordOptions キーワード分析のオプション
+type KeywordOptions struct {
+	TopN      int             // 表示する上位語数
+	MinCount  int             // 集計対象とする最小出現レビュー数
+	Stopwords map[string]bool // 除外する語 (nilの場合はデフォルトのストップワード)
+}
+
+// DefaultKeywordOptions デフォルトのキーワード分析オプションを返す
+func DefaultKeywordOptions() KeywordOptions {
+	return KeywordOptions{
+		TopN:      20,
+		MinCount:  3,
+		Stopwords: text.DefaultStopwords(),
+	}
+}
+
+// Keyword キーワードごとの集計
+type Keyword struct {
+	Term          string  `json:"term"`           // 語またはバイグラム
+	PositiveCount int     `json:"positive_count"` // 含まれる肯定的レビュー数
+	NegativeCount int     `json:"negative_count"` // 含まれる否定的レビュー数
+	Score         float64 `json:"score"`          // 対数オッズ比のzスコア（正: 肯定的寄り, 負: 否定的寄り）
+}
+
+// KeywordRanking 肯定的・否定的それぞれに特徴的な語のランキング
+type KeywordRanking struct {
+	Positive []Keyword `json:"positive"`
+	Negative []Keyword `json:"negative"`
+}
+
+// KeywordStats キーワード分析の結果
+type KeywordStats struct {
+	Terms   KeywordRanking `json:"terms"`
+	Bigrams KeywordRanking `json:"bigrams"`
+}
+
+// ComputeKeywordStats 肯定的・否定的レビューに特徴的な語とバイグラムを計算
+//
+// 出現数はレビュー単位（同じレビュー内の重複は1回）で数え、
+// 全体の出現数を事前分布とした対数オッズ比（Monroe et al. 2008）のzスコアで順位付けする。
+func ComputeKeywordStats(reviews []models.ReviewData, opts KeywordOptions) KeywordStats {
+	if opts.Stopwords == nil {
+		opts.Stopwords = text.DefaultStopwords()
+	}
+
+	termPos, termNeg := make(map[string]int), make(map[string]int)
+	bigramPos, bigramNeg := make(map[string]int), make(map[string]int)
+
+	for _, review := range reviews {
+		terms, bigrams := termPos, bigramPos
+		if !review.VotedUp {
+			terms, bigrams = termNeg, bigramNeg
+		}
+		for term := range uniqueStrings(text.Terms(review.Review, opts.Stopwords)) {
+			terms[term]++
+		}
+		for bigram := range uniqueStrings(text.Bigrams(review.Review, opts.Stopwords)) {
+			bigrams[bigram]++
+		}
+	}
+
+	return KeywordStats{
+		Terms:   rankKeywords(termPos, termNeg, opts),
+		Bigrams: rankKeywords(bigramPos, bigramNeg, opts),
+	}
+}
+
+// uniqueStrings 文字列スライスを集合に変換
+func uniqueStrings(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+// rankKeywords 対数オッズ比で語を順位付け
+func rankKeywords(pos, neg map[string]int, opts KeywordOptions) KeywordRanking {
+	totalPos, totalNeg := 0, 0
+	for _, c := range pos {
+		totalPos += c
+	}
+	for _, c := range neg {
+		totalNeg += c
+	}
+	// 事前分布の総量（肯定・否定を合わせた全体の出現数）
+	alpha0 := float64(totalPos + totalNeg)
+
+	var keywords []Keyword
+	seen := make(map[string]bool)
+	for _, counts := range []map[string]int{pos, neg} {
+		for term := range counts {
+			if seen[term] {
+				continue
+			}
+			seen[term] = true
+
+			yp, yn := float64(pos[term]), float64(neg[term])
+			if pos[term]+neg[term] < opts.MinCount {
+				continue
+			}
+			alpha := yp + yn
+			lp := math.Log((yp + alpha) / (float64(totalPos) + alpha0 - yp - alpha))
+			ln := math.Log((yn + alpha) / (float64(totalNeg) + alpha0 - yn - alpha))
+			variance := 1/(yp+alpha) + 1/(yn+alpha)
+			keywords = append(keywords, Keyword{
+				Term:          term,
+				PositiveCount: pos[term],
+				NegativeCount: neg[term],
+				Score:         (lp - ln) / math.Sqrt(variance),
+			})
+		}
+	}
+
+	sort.Slice(keywords, func(i, j int) bool {
+		if keywords[i].Score != keywords[j].Score {
+			return keywords[i].Score > keywords[j].Score
+		}
+		return keywords[i].Term < keywords[j].Term
+	})
+
+	var ranking KeywordRanking
+	for _, k := range keywords {
+		if k.Score <= 0 || len(ranking.Positive) >= opts.TopN {
+			break
+		}
+		ranking.Positive = append(ranking.Positive, k)
+	}
+	for i := len(keywords) - 1; i >= 0; i-- {
+		k := keywords[i]
+		if k.Score >= 0 || len(ranking.Negative) >= opts.TopN {
+			break
+		}
+		ranking.Negative = append(ranking.Negative, k)
+	}
+
+	return ranking
+}
+
+// printKeywordStats キーワード分析の結果を表形式で表示
+func printKeywordStats(w io.Writer, ks KeywordStats) {
+	sections := []struct {
+		title    string
+		keywords []Keyword
+	}{
+		{i18n.T(i18n.MsgStatsKeywordsPositiveTerms), ks.Terms.Positive},
+		{i18n.T(i18n.MsgStatsKeywordsNegativeTerms), ks.Terms.Negative},
+		{i18n.T(i18n.MsgStatsKeywordsPositiveBigrams), ks.Bigrams.Positive},
+		{i18n.T(i18n.MsgStatsKeywordsNegativeBigrams), ks.Bigrams.Negative},
+	}
+
+	for _, section := range sections {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, section.title)
+		if len(section.keywords) == 0 {
+			fmt.Fprintln(w, i18n.T(i18n.MsgStatsKeywordsNone))
+			continue
+		}
+		fmt.Fprintln(w, i18n.T(i18n.MsgStatsKeywordsHeader))
+		for i, k := range section.keywords {
+			fmt.Fprintln(w, fmt.Sprintf("  %3d  %-24s %8d %8d %8.2f",
+				i+1, k.Term, k.PositiveCount, k.NegativeCount, k.Score))
+		}
+	}
+}
+
+// WriteKeywordsCSV キーワード分析の結果をCSV形式で書き込む
+func WriteKeywordsCSV(w io.Writer, ks KeywordStats) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"kind", "polarity", "rank", "term", "positive_count", "negative_count", "score"}); err != nil {
+		return err
+	}
+
+	rows := []struct {
+		kind     string
+		polarity string
+		keywords []Keyword
+	}{
+		{"term", "positive", ks.Terms.Positive},
+		{"term", "negative", ks.Terms.Negative},
+		{"bigram", "positive", ks.Bigrams.Positive},
+		{"bigram", "negative", ks.Bigrams.Negative},
+	}
+	for _, row := range rows {
+		for i, k := range row.keywords {
+			record := []string{
+				row.kind,
+				row.polarity,
+				strconv.Itoa(i + 1),
+				k.Term,
+				strconv.Itoa(k.PositiveCount),
+				strconv.Itoa(k.NegativeCount),
+				strconv.FormatFloat(k.Score, 'f', 4, 64),
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
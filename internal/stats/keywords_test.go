@@ -0,0 +1,77 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestComputeKeywordStats(t *testing.T) {
+	var reviews []models.ReviewData
+	for i := 0; i < 5; i++ {
+		reviews = append(reviews,
+			models.ReviewData{VotedUp: true, Review: "beautiful music and great story"},
+			models.ReviewData{VotedUp: false, Review: "constant crashes and bad optimization"},
+		)
+	}
+
+	opts := DefaultKeywordOptions()
+	opts.TopN = 3
+	ks := ComputeKeywordStats(reviews, opts)
+
+	if len(ks.Terms.Positive) != 3 {
+		t.Fatalf("len(Terms.Positive) = %d, want 3", len(ks.Terms.Positive))
+	}
+	for _, k := range ks.Terms.Positive {
+		if k.NegativeCount != 0 || k.Score <= 0 {
+			t.Errorf("positive term %+v should only appear in positive reviews", k)
+		}
+	}
+	for _, k := range ks.Terms.Negative {
+		if k.PositiveCount != 0 || k.Score >= 0 {
+			t.Errorf("negative term %+v should only appear in negative reviews", k)
+		}
+		if k.Term == "and" {
+			t.Errorf("stopword %q should be excluded", k.Term)
+		}
+	}
+
+	found := false
+	for _, k := range ks.Bigrams.Negative {
+		if k.Term == "constant crashes" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Bigrams.Negative = %+v, want to contain %q", ks.Bigrams.Negative, "constant crashes")
+	}
+}
+
+func TestWriteKeywordsCSV(t *testing.T) {
+	ks := KeywordStats{
+		Terms: KeywordRanking{
+			Positive: []Keyword{{Term: "fun", PositiveCount: 10, NegativeCount: 1, Score: 2.5}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteKeywordsCSV(&buf, ks); err != nil {
+		t.Fatalf("WriteKeywordsCSV() error = %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read CSV: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("len(records) = %d, want 2", len(records))
+	}
+	expected := []string{"term", "positive", "1", "fun", "10", "1", "2.5000"}
+	for i, v := range expected {
+		if records[1][i] != v {
+			t.Errorf("records[1][%d] = %q, want %q", i, records[1][i], v)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package stats
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/internal/text"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// lengthBucketBounds レビュー本文の文字数区分の境界
+var lengthBucketBounds = []int{20, 100, 500, 2000}
+
+// LengthBucket 文字数区分ごとの集計
+type LengthBucket struct {
+	Label         string  `json:"label"`          // 表示用ラベル (例: "100-500")
+	MinRunes      int     `json:"min_runes"`      // 下限（文字数、この値を含む）
+	MaxRunes      int     `json:"max_runes"`      // 上限（文字数、この値を含まない。0は上限なし）
+	Total         int     `json:"total"`          // レビュー数
+	Positive      int     `json:"positive"`       // 肯定的レビュー数
+	PositiveRatio float64 `json:"positive_ratio"` // 肯定的レビューの割合 (%)
+}
+
+// LengthStats レビュー本文の長さと形状の統計
+type LengthStats struct {
+	MeanRunes         float64        `json:"mean_runes"`          // 文字数の平均
+	MedianRunes       float64        `json:"median_runes"`        // 文字数の中央値
+	MeanWords         float64        `json:"mean_words"`          // 単語数の平均（CJKは1文字1語）
+	MedianWords       float64        `json:"median_words"`        // 単語数の中央値
+	MeanRunesPositive float64        `json:"mean_runes_positive"` // 肯定的レビューの文字数の平均
+	MeanRunesNegative float64        `json:"mean_runes_negative"` // 否定的レビューの文字数の平均
+	Buckets           []LengthBucket `json:"buckets"`
+	OneLine           int            `json:"one_line"`        // 1行のみのレビュー数
+	OneLineShare      float64        `json:"one_line_share"`  // 1行のみのレビューの割合 (%)
+	WithURL           int            `json:"with_url"`        // URLを含むレビュー数
+	WithURLShare      float64        `json:"with_url_share"`  // URLを含むレビューの割合 (%)
+	ASCIIArt          int            `json:"ascii_art"`       // アスキーアートを含むレビュー数
+	ASCIIArtShare     float64        `json:"ascii_art_share"` // アスキーアートを含むレビューの割合 (%)
+}
+
+// newLengthBuckets 境界から文字数区分を作成
+func newLengthBuckets(bounds []int) []LengthBucket {
+	buckets := make([]LengthBucket, 0, len(bounds)+1)
+	lower := 0
+	for _, upper := range bounds {
+		label := fmt.Sprintf("%d-%d", lower, upper)
+		if lower == 0 {
+			label = fmt.Sprintf("<%d", upper)
+		}
+		buckets = append(buckets, LengthBucket{Label: label, MinRunes: lower, MaxRunes: upper})
+		lower = upper
+	}
+	return append(buckets, LengthBucket{Label: fmt.Sprintf("%d+", lower), MinRunes: lower})
+}
+
+// ComputeLengthStats レビュー本文の長さと形状の統計を計算
+func ComputeLengthStats(reviews []models.ReviewData) LengthStats {
+	ls := LengthStats{Buckets: newLengthBuckets(lengthBucketBounds)}
+	var runes, words, positiveRunes, negativeRunes []float64
+
+	for _, review := range reviews {
+		n := text.RuneCount(review.Review)
+		runes = append(runes, float64(n))
+		words = append(words, float64(text.WordCount(review.Review)))
+		if review.VotedUp {
+			positiveRunes = append(positiveRunes, float64(n))
+		} else {
+			negativeRunes = append(negativeRunes, float64(n))
+		}
+
+		for i := range ls.Buckets {
+			b := &ls.Buckets[i]
+			if b.MaxRunes == 0 || n < b.MaxRunes {
+				b.Total++
+				if review.VotedUp {
+					b.Positive++
+				}
+				break
+			}
+		}
+
+		if text.IsOneLine(review.Review) {
+			ls.OneLine++
+		}
+		if text.HasURL(review.Review) {
+			ls.WithURL++
+		}
+		if text.HasASCIIArt(review.Review) {
+			ls.ASCIIArt++
+		}
+	}
+
+	ls.MeanRunes = mean(runes)
+	ls.MedianRunes = median(runes)
+	ls.MeanWords = mean(words)
+	ls.MedianWords = median(words)
+	ls.MeanRunesPositive = mean(positiveRunes)
+	ls.MeanRunesNegative = mean(negativeRunes)
+	for i := range ls.Buckets {
+		ls.Buckets[i].PositiveRatio = percent(ls.Buckets[i].Positive, ls.Buckets[i].Total)
+	}
+	ls.OneLineShare = percent(ls.OneLine, len(reviews))
+	ls.WithURLShare = percent(ls.WithURL, len(reviews))
+	ls.ASCIIArtShare = percent(ls.ASCIIArt, len(reviews))
+
+	return ls
+}
+
+// printLengthStats レビュー本文の長さと形状の統計を表示
+func printLengthStats(w io.Writer, ls LengthStats) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgStatsLengthTitle))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsLengthRunes, ls.MeanRunes, ls.MedianRunes))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsLengthWords, ls.MeanWords, ls.MedianWords))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsLengthByVote, ls.MeanRunesPositive, ls.MeanRunesNegative))
+	for _, b := range ls.Buckets {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsLengthBucket, b.Label, b.Total, b.Positive, b.PositiveRatio))
+	}
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsLengthOneLine, ls.OneLine, ls.OneLineShare))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsLengthURL, ls.WithURL, ls.WithURLShare))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsLengthASCIIArt, ls.ASCIIArt, ls.ASCIIArtShare))
+}
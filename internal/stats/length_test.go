@@ -0,0 +1,42 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestComputeLengthStats(t *testing.T) {
+	reviews := []models.ReviewData{
+		{Review: "最高", VotedUp: true},
+		{Review: "Fun game with friends", VotedUp: true},
+		{Review: "Crashes constantly.\nSee https://example.com/bug for the report.\nRefunded after an hour of trying to fix it.", VotedUp: false},
+		{Review: "⣿⣿⣿⣿⠿⠿⣿⣿⣿⣿\n⣿⣿⠏⠀⠀⠀⠀⠹⣿⣿\n⣿⣿⣄⠀⠀⠀⠀⣠⣿⣿", VotedUp: true},
+	}
+
+	ls := ComputeLengthStats(reviews)
+
+	if ls.Buckets[0].Label != "<20" || ls.Buckets[0].Total != 1 || ls.Buckets[1].Total != 2 || ls.Buckets[2].Total != 1 {
+		t.Errorf("Buckets = %+v", ls.Buckets)
+	}
+	if ls.Buckets[len(ls.Buckets)-1].Label != "2000+" {
+		t.Errorf("last bucket label = %q, want 2000+", ls.Buckets[len(ls.Buckets)-1].Label)
+	}
+	if ls.OneLine != 2 || ls.WithURL != 1 || ls.ASCIIArt != 1 {
+		t.Errorf("one-line/url/art = %d/%d/%d, want 2/1/1", ls.OneLine, ls.WithURL, ls.ASCIIArt)
+	}
+	if ls.OneLineShare != 50 {
+		t.Errorf("OneLineShare = %.1f, want 50.0", ls.OneLineShare)
+	}
+	// 肯定的: 2, 21, 32文字 / 否定的: 1件
+	if ls.MeanRunesPositive != 55.0/3 {
+		t.Errorf("MeanRunesPositive = %.2f, want %.2f", ls.MeanRunesPositive, 55.0/3)
+	}
+	if ls.MeanRunesNegative <= ls.MeanRunesPositive {
+		t.Errorf("MeanRunesNegative = %.1f, want longer than positive", ls.MeanRunesNegative)
+	}
+	// 単語数: 0 (アスキーアート), 2, 4, 20
+	if ls.MedianWords != 3 {
+		t.Errorf("MedianWords = %.1f, want 3.0", ls.MedianWords)
+	}
+}
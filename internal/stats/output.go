@@ -0,0 +1,240 @@
+package stats
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// Write 指定された形式 (text, json, csv) で統計を書き込む
+func Write(w io.Writer, rs ReviewStats, format string) error {
+	switch format {
+	case config.StatsFormatText:
+		// 書き込みエラーは bufio.Writer に保持され、Flush で返される
+		bw := bufio.NewWriter(w)
+		Print(bw, rs)
+		return bw.Flush()
+	case config.StatsFormatJSON:
+		return WriteJSON(w, rs)
+	case config.StatsFormatCSV:
+		return WriteCSV(w, rs)
+	default:
+		return errors.New(i18n.Tf(i18n.MsgErrorStatsFormat, format))
+	}
+}
+
+// WriteJSON 統計をJSON形式で書き込む
+func WriteJSON(w io.Writer, rs ReviewStats) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(rs)
+}
+
+// WriteCSV 統計を "section,group,metric,value" の縦持ちCSV形式で書き込む
+func WriteCSV(w io.Writer, rs ReviewStats) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"section", "group", "metric", "value"}); err != nil {
+		return err
+	}
+	return writer.WriteAll(csvRows(rs))
+}
+
+// StreamWriter 複数のゲームの統計を1つの出力に続けて書き込む
+//
+// JSON は1ゲーム1行の JSON Lines、CSV はヘッダーを最初の1回だけ書き込むため、
+// 書き込んだゲームの数によらず出力全体を1つのデータとして読み込める。
+type StreamWriter struct {
+	w      io.Writer
+	format string // json または csv
+	count  int    // 書き込んだ統計の数
+}
+
+// NewStreamWriter w に format (json, csv) で書き込む StreamWriter を作成
+func NewStreamWriter(w io.Writer, format string) *StreamWriter {
+	return &StreamWriter{w: w, format: format}
+}
+
+// Write 統計を1件書き込む
+func (s *StreamWriter) Write(rs ReviewStats) error {
+	switch s.format {
+	case config.StatsFormatJSON:
+		if err := json.NewEncoder(s.w).Encode(rs); err != nil {
+			return err
+		}
+	case config.StatsFormatCSV:
+		writer := csv.NewWriter(s.w)
+		if s.count == 0 {
+			if err := writer.Write([]string{"section", "group", "metric", "value"}); err != nil {
+				return err
+			}
+		}
+		if err := writer.WriteAll(csvRows(rs)); err != nil {
+			return err
+		}
+	default:
+		return errors.New(i18n.Tf(i18n.MsgErrorStatsFormat, s.format))
+	}
+	s.count++
+	return nil
+}
+
+// csvRows 統計をCSVの行に変換
+func csvRows(rs ReviewStats) [][]string {
+	var rows [][]string
+	add := func(section, group, metric string, value interface{}) {
+		var s string
+		switch v := value.(type) {
+		case float64:
+			s = strconv.FormatFloat(v, 'f', 4, 64)
+		default:
+			s = fmt.Sprint(v)
+		}
+		rows = append(rows, []string{section, group, metric, s})
+	}
+
+	add("summary", "", "game_name", rs.GameName)
+	add("summary", "", "total_reviews", rs.TotalReviews)
+	add("summary", "", "positive", rs.Positive)
+	add("summary", "", "negative", rs.Negative)
+	add("summary", "", "positive_ratio", rs.PositiveRatio)
+	add("summary", "", "negative_ratio", rs.NegativeRatio)
+
+	for _, ls := range rs.Languages {
+		add("language", ls.Language, "total", ls.Total)
+		add("language", ls.Language, "positive", ls.Positive)
+		add("language", ls.Language, "negative", ls.Negative)
+		add("language", ls.Language, "share", ls.Share)
+		add("language", ls.Language, "positive_ratio", ls.PositiveRatio)
+	}
+
+	for _, p := range rs.Timeline.Points {
+		add("timeline_"+rs.Timeline.Period, p.Start, "total", p.Total)
+		add("timeline_"+rs.Timeline.Period, p.Start, "positive", p.Positive)
+	}
+
+	for _, b := range rs.Playtime.Buckets {
+		add("playtime", b.Label, "total", b.Total)
+		add("playtime", b.Label, "positive", b.Positive)
+		add("playtime", b.Label, "positive_ratio", b.PositiveRatio)
+	}
+	add("playtime", "", "median_positive_hours", rs.Playtime.MedianPositiveHours)
+	add("playtime", "", "median_negative_hours", rs.Playtime.MedianNegativeHours)
+	add("playtime", "still_playing", "total", rs.Playtime.StillPlaying)
+	add("playtime", "still_playing", "positive", rs.Playtime.StillPlayingPos)
+	add("playtime", "not_playing", "total", rs.Playtime.NotPlaying)
+	add("playtime", "not_playing", "positive", rs.Playtime.NotPlayingPos)
+
+	ds := rs.DeveloperResponse
+	for _, r := range append([]ResponseRate{ds.Overall, ds.Positive, ds.Negative}, ds.ByLanguage...) {
+		add("developer_response", r.Group, "total", r.Total)
+		add("developer_response", r.Group, "responded", r.Responded)
+		add("developer_response", r.Group, "rate", r.Rate)
+	}
+	for _, b := range ds.Latency {
+		add("developer_response_latency", b.Label, "count", b.Count)
+	}
+	add("developer_response_latency", "", "median_hours", ds.MedianLatencyHours)
+	add("developer_response", "", "unanswered_negative", ds.UnansweredNegative)
+
+	hs := rs.Helpfulness
+	add("helpfulness", "", "vote_weighted_positive", hs.VoteWeightedPositive)
+	add("helpfulness", "", "score_weighted_positive", hs.ScoreWeightedPositive)
+	for _, ci := range append([]ConfidenceInterval{hs.Overall}, hs.ByLanguage...) {
+		add("wilson", ci.Group, "lower", ci.Lower)
+		add("wilson", ci.Group, "upper", ci.Upper)
+	}
+	for i, review := range hs.TopPositive {
+		add("top_positive", strconv.Itoa(i+1), "recommendation_id", review.RecommendationID)
+		add("top_positive", strconv.Itoa(i+1), "votes_up", review.VotesUp)
+	}
+	for i, review := range hs.TopNegative {
+		add("top_negative", strconv.Itoa(i+1), "recommendation_id", review.RecommendationID)
+		add("top_negative", strconv.Itoa(i+1), "votes_up", review.VotesUp)
+	}
+
+	sources := []struct {
+		name string
+		sc   SegmentComparison
+	}{
+		{"source_early_access", rs.Sources.EarlyAccess},
+		{"source_purchase", rs.Sources.Purchase},
+		{"source_free_copy", rs.Sources.FreeCopy},
+	}
+	for _, source := range sources {
+		for _, g := range []SegmentGroup{source.sc.A, source.sc.B} {
+			add(source.name, g.Label, "total", g.Total)
+			add(source.name, g.Label, "positive", g.Positive)
+			add(source.name, g.Label, "positive_ratio", g.PositiveRatio)
+		}
+		add(source.name, "", "difference", source.sc.Difference)
+		add(source.name, "", "z_score", source.sc.ZScore)
+		add(source.name, "", "p_value", source.sc.PValue)
+		add(source.name, "", "significant", source.sc.Significant)
+	}
+
+	for _, s := range rs.Reviewers.Segments {
+		add("reviewer", s.Segment, "total", s.Total)
+		add("reviewer", s.Segment, "positive", s.Positive)
+		add("reviewer", s.Segment, "positive_ratio", s.PositiveRatio)
+		add("reviewer", s.Segment, "median_playtime_hours", s.MedianPlaytimeHours)
+	}
+	for _, c := range rs.Reviewers.SuspiciousClusters {
+		add("suspicious_cluster", c.Start, "end", c.End)
+		add("suspicious_cluster", c.Start, "count", c.Count)
+		add("suspicious_cluster", c.Start, "window_total", c.WindowTotal)
+		add("suspicious_cluster", c.Start, "positive_ratio", c.PositiveRatio)
+	}
+
+	ls := rs.Length
+	add("length", "", "mean_runes", ls.MeanRunes)
+	add("length", "", "median_runes", ls.MedianRunes)
+	add("length", "", "mean_words", ls.MeanWords)
+	add("length", "", "median_words", ls.MedianWords)
+	add("length", "positive", "mean_runes", ls.MeanRunesPositive)
+	add("length", "negative", "mean_runes", ls.MeanRunesNegative)
+	for _, b := range ls.Buckets {
+		add("length", b.Label, "total", b.Total)
+		add("length", b.Label, "positive", b.Positive)
+		add("length", b.Label, "positive_ratio", b.PositiveRatio)
+	}
+	add("length", "", "one_line", ls.OneLine)
+	add("length", "", "with_url", ls.WithURL)
+	add("length", "", "ascii_art", ls.ASCIIArt)
+
+	if ss := rs.Sentiment; ss != nil {
+		add("sentiment", "", "scored", ss.Scored)
+		add("sentiment", "", "mean", ss.Mean)
+		add("sentiment", "", "mean_positive", ss.MeanPositive)
+		add("sentiment", "", "mean_negative", ss.MeanNegative)
+		for _, b := range ss.Buckets {
+			add("sentiment", b.Label, "count", b.Count)
+		}
+		add("sentiment", "", "mismatched", len(ss.Mismatched))
+	}
+
+	if ks := rs.Keywords; ks != nil {
+		sections := []struct {
+			name     string
+			keywords []Keyword
+		}{
+			{"keywords_term_positive", ks.Terms.Positive},
+			{"keywords_term_negative", ks.Terms.Negative},
+			{"keywords_bigram_positive", ks.Bigrams.Positive},
+			{"keywords_bigram_negative", ks.Bigrams.Negative},
+		}
+		for _, section := range sections {
+			for _, k := range section.keywords {
+				add(section.name, k.Term, "score", k.Score)
+			}
+		}
+	}
+
+	return rows
+}
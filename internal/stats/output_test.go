@@ -0,0 +1,166 @@
+package stats
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+func sampleReviews() []models.ReviewData {
+	return []models.ReviewData{
+		{RecommendationID: "1", Language: "english", VotedUp: true},
+		{RecommendationID: "2", Language: "japanese", VotedUp: true},
+		{RecommendationID: "3", Language: "japanese", VotedUp: false},
+		{RecommendationID: "4", Language: "schinese", VotedUp: true},
+		{RecommendationID: "5", Language: "", VotedUp: false},
+	}
+}
+
+func TestComputeLanguageOrder(t *testing.T) {
+	rs := Compute(sampleReviews(), "Test Game", DefaultOptions())
+
+	if rs.TotalReviews != 5 || rs.Positive != 3 || rs.Negative != 2 {
+		t.Errorf("summary = %d/%d/%d, want 5/3/2", rs.TotalReviews, rs.Positive, rs.Negative)
+	}
+
+	expected := []string{"japanese", "english", "schinese", "unknown"}
+	for i := 0; i < 10; i++ {
+		rs := Compute(sampleReviews(), "Test Game", DefaultOptions())
+		if len(rs.Languages) != len(expected) {
+			t.Fatalf("len(Languages) = %d, want %d", len(rs.Languages), len(expected))
+		}
+		for j, lang := range expected {
+			if rs.Languages[j].Language != lang {
+				t.Fatalf("Languages[%d] = %q, want %q", j, rs.Languages[j].Language, lang)
+			}
+		}
+	}
+	if rs.Sentiment != nil || rs.Keywords != nil {
+		t.Error("optional sections should be nil when disabled")
+	}
+}
+
+func TestLanguageNameIndependentOfLocale(t *testing.T) {
+	previous := i18n.GetCurrentLanguage()
+	defer i18n.SetLanguage(previous)
+	i18n.SetLanguage("ja")
+
+	rs := Compute(sampleReviews(), "Test Game", DefaultOptions())
+	if rs.Languages[0].Language != "japanese" || rs.Languages[0].Name != "Japanese" {
+		t.Errorf("Languages[0] = %+v, want English name Japanese", rs.Languages[0])
+	}
+
+	// テキストの統計だけを表示言語で表示する
+	var buf bytes.Buffer
+	Print(&buf, rs)
+	if !strings.Contains(buf.String(), "日本語 (japanese)") {
+		t.Errorf("text output does not contain the translated language name: %q", buf.String())
+	}
+}
+
+func TestWriteFormats(t *testing.T) {
+	rs := Compute(sampleReviews(), "Test Game", DefaultOptions())
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := Write(&buf, rs, config.StatsFormatJSON); err != nil {
+			t.Fatalf("Write(json) error = %v", err)
+		}
+		var decoded ReviewStats
+		if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+			t.Fatalf("invalid JSON: %v", err)
+		}
+		if decoded.GameName != "Test Game" || decoded.TotalReviews != 5 {
+			t.Errorf("decoded = %+v, want game Test Game with 5 reviews", decoded)
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := Write(&buf, rs, config.StatsFormatCSV); err != nil {
+			t.Fatalf("Write(csv) error = %v", err)
+		}
+		records, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatalf("invalid CSV: %v", err)
+		}
+		if strings.Join(records[0], ",") != "section,group,metric,value" {
+			t.Errorf("header = %v", records[0])
+		}
+		if strings.Join(records[2], ",") != "summary,,total_reviews,5" {
+			t.Errorf("records[2] = %v, want summary,,total_reviews,5", records[2])
+		}
+	})
+
+	t.Run("text", func(t *testing.T) {
+		var buf bytes.Buffer
+		if err := Write(&buf, rs, config.StatsFormatText); err != nil {
+			t.Fatalf("Write(text) error = %v", err)
+		}
+		if !strings.Contains(buf.String(), "Test Game") {
+			t.Errorf("text output does not contain game name: %q", buf.String())
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		if err := Write(&bytes.Buffer{}, rs, "xml"); err == nil {
+			t.Error("Write(xml) should return error")
+		}
+	})
+}
+
+func TestStreamWriter(t *testing.T) {
+	games := []ReviewStats{
+		Compute(sampleReviews(), "Game A", DefaultOptions()),
+		Compute(sampleReviews(), "Game B", DefaultOptions()),
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		sw := NewStreamWriter(&buf, config.StatsFormatJSON)
+		for _, rs := range games {
+			if err := sw.Write(rs); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+		}
+		lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+		if len(lines) != 2 {
+			t.Fatalf("got %d lines, want 2: %q", len(lines), buf.String())
+		}
+		for i, line := range lines {
+			var decoded ReviewStats
+			if err := json.Unmarshal([]byte(line), &decoded); err != nil || decoded.GameName != games[i].GameName {
+				t.Errorf("line %d = %q (error %v), want stats of %s", i, line, err, games[i].GameName)
+			}
+		}
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		var buf bytes.Buffer
+		sw := NewStreamWriter(&buf, config.StatsFormatCSV)
+		for _, rs := range games {
+			if err := sw.Write(rs); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+		}
+		records, err := csv.NewReader(&buf).ReadAll()
+		if err != nil {
+			t.Fatalf("invalid CSV: %v", err)
+		}
+		headers := 0
+		for _, record := range records {
+			if record[0] == "section" {
+				headers++
+			}
+		}
+		if headers != 1 || len(records) != 1+2*len(csvRows(games[0])) {
+			t.Errorf("got %d headers and %d records, want 1 header and the rows of both games", headers, len(records))
+		}
+	})
+}
@@ -0,0 +1,142 @@
+package stats
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// DefaultPlaytimeBuckets プレイ時間区分のデフォルト境界（時間単位）
+// <1h, 1–5h, 5–20h, 20–100h, 100h+ の5区分になる
+var DefaultPlaytimeBuckets = []int{1, 5, 20, 100}
+
+// PlaytimeBucket プレイ時間区分ごとの集計
+type PlaytimeBucket struct {
+	Label         string  `json:"label"`          // 表示用ラベル (例: "1-5h")
+	MinHours      int     `json:"min_hours"`      // 下限（時間、この値を含む）
+	MaxHours      int     `json:"max_hours"`      // 上限（時間、この値を含まない。0は上限なし）
+	Total         int     `json:"total"`          // レビュー数
+	Positive      int     `json:"positive"`       // 肯定的レビュー数
+	PositiveRatio float64 `json:"positive_ratio"` // 肯定的レビューの割合 (%)
+}
+
+// PlaytimeStats プレイ時間別の統計
+type PlaytimeStats struct {
+	Buckets             []PlaytimeBucket `json:"buckets"`
+	MedianPositiveHours float64          `json:"median_positive_hours"`  // 肯定的レビュー投稿者のレビュー時点プレイ時間の中央値
+	MedianNegativeHours float64          `json:"median_negative_hours"`  // 否定的レビュー投稿者のレビュー時点プレイ時間の中央値
+	StillPlaying        int              `json:"still_playing"`          // 直近2週間にプレイしているレビュー投稿者数
+	StillPlayingPos     int              `json:"still_playing_positive"` // そのうち肯定的レビュー数
+	NotPlaying          int              `json:"not_playing"`            // 直近2週間にプレイしていないレビュー投稿者数
+	NotPlayingPos       int              `json:"not_playing_positive"`   // そのうち肯定的レビュー数
+}
+
+// ParsePlaytimeBuckets カンマ区切りのプレイ時間境界（時間単位）をパース
+func ParsePlaytimeBuckets(s string) ([]int, error) {
+	var bounds []int
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil || n <= 0 {
+			return nil, errors.New(i18n.Tf(i18n.MsgErrorPlaytimeBuckets, s))
+		}
+		if len(bounds) > 0 && n <= bounds[len(bounds)-1] {
+			return nil, errors.New(i18n.Tf(i18n.MsgErrorPlaytimeBuckets, s))
+		}
+		bounds = append(bounds, n)
+	}
+	if len(bounds) == 0 {
+		return nil, errors.New(i18n.Tf(i18n.MsgErrorPlaytimeBuckets, s))
+	}
+	return bounds, nil
+}
+
+// newPlaytimeBuckets 境界リストからプレイ時間区分を作成
+func newPlaytimeBuckets(bounds []int) []PlaytimeBucket {
+	buckets := make([]PlaytimeBucket, 0, len(bounds)+1)
+	lower := 0
+	for _, upper := range bounds {
+		label := fmt.Sprintf("%d-%dh", lower, upper)
+		if lower == 0 {
+			label = fmt.Sprintf("<%dh", upper)
+		}
+		buckets = append(buckets, PlaytimeBucket{Label: label, MinHours: lower, MaxHours: upper})
+		lower = upper
+	}
+	buckets = append(buckets, PlaytimeBucket{Label: fmt.Sprintf("%dh+", lower), MinHours: lower})
+	return buckets
+}
+
+// ComputePlaytimeStats レビュー時点のプレイ時間別に統計を計算
+func ComputePlaytimeStats(reviews []models.ReviewData, bounds []int) PlaytimeStats {
+	if len(bounds) == 0 {
+		bounds = DefaultPlaytimeBuckets
+	}
+
+	ps := PlaytimeStats{Buckets: newPlaytimeBuckets(bounds)}
+	var positiveHours, negativeHours []float64
+
+	for _, review := range reviews {
+		minutes := review.Author.PlaytimeAtReview
+		for i := range ps.Buckets {
+			b := &ps.Buckets[i]
+			if b.MaxHours == 0 || minutes < b.MaxHours*60 {
+				b.Total++
+				if review.VotedUp {
+					b.Positive++
+				}
+				break
+			}
+		}
+
+		hours := float64(minutes) / 60
+		if review.VotedUp {
+			positiveHours = append(positiveHours, hours)
+		} else {
+			negativeHours = append(negativeHours, hours)
+		}
+
+		if review.Author.PlaytimeLastTwoWeeks > 0 {
+			ps.StillPlaying++
+			if review.VotedUp {
+				ps.StillPlayingPos++
+			}
+		} else {
+			ps.NotPlaying++
+			if review.VotedUp {
+				ps.NotPlayingPos++
+			}
+		}
+	}
+
+	for i := range ps.Buckets {
+		ps.Buckets[i].PositiveRatio = percent(ps.Buckets[i].Positive, ps.Buckets[i].Total)
+	}
+	ps.MedianPositiveHours = median(positiveHours)
+	ps.MedianNegativeHours = median(negativeHours)
+
+	return ps
+}
+
+// printPlaytimeStats プレイ時間別の統計を表示
+func printPlaytimeStats(w io.Writer, ps PlaytimeStats, total int) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgStatsPlaytimeBreakdown))
+	for _, b := range ps.Buckets {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsPlaytimeBucket,
+			b.Label, b.Total, b.Positive, b.PositiveRatio))
+	}
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsPlaytimeMedian, ps.MedianPositiveHours, ps.MedianNegativeHours))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsStillPlaying,
+		ps.StillPlaying, percent(ps.StillPlaying, total), percent(ps.StillPlayingPos, ps.StillPlaying)))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsNotPlaying,
+		ps.NotPlaying, percent(ps.NotPlaying, total), percent(ps.NotPlayingPos, ps.NotPlaying)))
+}
@@ -0,0 +1,112 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestParsePlaytimeBuckets(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []int
+		wantErr  bool
+	}{
+		{
+			name:     "Default buckets",
+			input:    "1,5,20,100",
+			expected: []int{1, 5, 20, 100},
+		},
+		{
+			name:     "With spaces",
+			input:    " 2, 10 ",
+			expected: []int{2, 10},
+		},
+		{
+			name:    "Not ascending",
+			input:   "5,1",
+			wantErr: true,
+		},
+		{
+			name:    "Non numeric",
+			input:   "1,abc",
+			wantErr: true,
+		},
+		{
+			name:    "Empty",
+			input:   "",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParsePlaytimeBuckets(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePlaytimeBuckets(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("ParsePlaytimeBuckets(%q) = %v, want %v", tt.input, result, tt.expected)
+			}
+			for i := range result {
+				if result[i] != tt.expected[i] {
+					t.Errorf("ParsePlaytimeBuckets(%q)[%d] = %d, want %d", tt.input, i, result[i], tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestComputePlaytimeStats(t *testing.T) {
+	review := func(votedUp bool, atReview, lastTwoWeeks int) models.ReviewData {
+		return models.ReviewData{
+			VotedUp: votedUp,
+			Author: models.AuthorData{
+				PlaytimeAtReview:     atReview,
+				PlaytimeLastTwoWeeks: lastTwoWeeks,
+			},
+		}
+	}
+
+	reviews := []models.ReviewData{
+		review(false, 30, 0),    // <1h
+		review(true, 45, 10),    // <1h
+		review(true, 120, 0),    // 1-5h
+		review(true, 600, 60),   // 5-20h
+		review(false, 9000, 0),  // 100h+
+		review(false, 12000, 0), // 100h+
+	}
+
+	ps := ComputePlaytimeStats(reviews, nil)
+
+	expectedLabels := []string{"<1h", "1-5h", "5-20h", "20-100h", "100h+"}
+	expectedTotals := []int{2, 1, 1, 0, 2}
+	if len(ps.Buckets) != len(expectedLabels) {
+		t.Fatalf("len(Buckets) = %d, want %d", len(ps.Buckets), len(expectedLabels))
+	}
+	for i, b := range ps.Buckets {
+		if b.Label != expectedLabels[i] {
+			t.Errorf("Buckets[%d].Label = %q, want %q", i, b.Label, expectedLabels[i])
+		}
+		if b.Total != expectedTotals[i] {
+			t.Errorf("Buckets[%d].Total = %d, want %d", i, b.Total, expectedTotals[i])
+		}
+	}
+	if ps.Buckets[0].PositiveRatio != 50 {
+		t.Errorf("Buckets[0].PositiveRatio = %v, want 50", ps.Buckets[0].PositiveRatio)
+	}
+
+	if ps.MedianPositiveHours != 2 {
+		t.Errorf("MedianPositiveHours = %v, want 2", ps.MedianPositiveHours)
+	}
+	if ps.MedianNegativeHours != 150 {
+		t.Errorf("MedianNegativeHours = %v, want 150", ps.MedianNegativeHours)
+	}
+	if ps.StillPlaying != 2 || ps.StillPlayingPos != 2 {
+		t.Errorf("StillPlaying = %d (positive %d), want 2 (positive 2)", ps.StillPlaying, ps.StillPlayingPos)
+	}
+	if ps.NotPlaying != 4 || ps.NotPlayingPos != 1 {
+		t.Errorf("NotPlaying = %d (positive %d), want 4 (positive 1)", ps.NotPlaying, ps.NotPlayingPos)
+	}
+}
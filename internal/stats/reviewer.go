@@ -0,0 +1,207 @@
+package stats
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// レビュー投稿者の区分
+const (
+	SegmentNewAccount = "new_account" // 所持ゲーム・レビュー数がともに少ないアカウント
+	SegmentProlific   = "prolific"    // レビュー数の多い投稿者
+	SegmentCollector  = "collector"   // 所持ゲーム数の多い投稿者
+	SegmentRegular    = "regular"     // 上記以外
+)
+
+// 投稿者区分と不審なクラスタ検出の閾値
+const (
+	newAccountMaxGames   = 5
+	newAccountMaxReviews = 2
+	prolificMinReviews   = 50
+	collectorMinGames    = 500
+
+	suspiciousMaxPlaytimeMinutes = 30             // レビュー時点のプレイ時間がこれ以下
+	suspiciousMaxGames           = 5              // 所持ゲーム数がこれ以下
+	suspiciousWindow             = 24 * time.Hour // この期間内に
+	suspiciousMinClusterSize     = 5              // これ以上の件数が集中していればクラスタとみなす
+)
+
+// ReviewerSegment 投稿者区分ごとの集計
+type ReviewerSegment struct {
+	Segment             string  `json:"segment"`
+	Total               int     `json:"total"`
+	Positive            int     `json:"positive"`
+	PositiveRatio       float64 `json:"positive_ratio"`        // 肯定的レビューの割合 (%)
+	Share               float64 `json:"share"`                 // 全体に占める割合 (%)
+	MedianPlaytimeHours float64 `json:"median_playtime_hours"` // レビュー時点プレイ時間の中央値
+	Scored              int     `json:"scored"`                // 感情スコアのあるレビュー数
+	MeanSentiment       float64 `json:"mean_sentiment"`        // 感情スコアの平均（Scored が0の場合は0）
+}
+
+// SuspiciousCluster 短期間に集中した低プレイ時間・少所持ゲームのアカウントによるレビュー
+type SuspiciousCluster struct {
+	Start             string   `json:"start"`              // 最初のレビューの投稿日時 (UTC)
+	End               string   `json:"end"`                // 最後のレビューの投稿日時 (UTC)
+	Count             int      `json:"count"`              // クラスタに含まれるレビュー数
+	Positive          int      `json:"positive"`           // そのうち肯定的レビュー数
+	PositiveRatio     float64  `json:"positive_ratio"`     // 肯定的レビューの割合 (%)
+	WindowTotal       int      `json:"window_total"`       // 同じ期間に投稿された全レビュー数
+	RecommendationIDs []string `json:"recommendation_ids"` // クラスタに含まれるレビューのID
+}
+
+// ReviewerStats 投稿者プロフィール別の統計
+type ReviewerStats struct {
+	Segments           []ReviewerSegment   `json:"segments"`            // SegmentNewAccount, SegmentProlific, SegmentCollector, SegmentRegular の順
+	SuspiciousClusters []SuspiciousCluster `json:"suspicious_clusters"` // 古い順
+}
+
+// ReviewerSegmentOf 投稿者の所持ゲーム数とレビュー数から区分を判定
+//
+// 非公開プロフィールでは所持ゲーム数が0になるため、新規アカウントに分類されることがある。
+func ReviewerSegmentOf(author models.AuthorData) string {
+	switch {
+	case author.NumGamesOwned <= newAccountMaxGames && author.NumReviews <= newAccountMaxReviews:
+		return SegmentNewAccount
+	case author.NumReviews >= prolificMinReviews:
+		return SegmentProlific
+	case author.NumGamesOwned >= collectorMinGames:
+		return SegmentCollector
+	default:
+		return SegmentRegular
+	}
+}
+
+// isSuspiciousReviewer 不審なクラスタの候補となるレビューか判定
+func isSuspiciousReviewer(review models.ReviewData) bool {
+	return review.TimestampCreated > 0 &&
+		review.Author.PlaytimeAtReview <= suspiciousMaxPlaytimeMinutes &&
+		review.Author.NumGamesOwned <= suspiciousMaxGames
+}
+
+// ComputeReviewerStats 投稿者プロフィール別の統計を計算
+func ComputeReviewerStats(reviews []models.ReviewData) ReviewerStats {
+	order := []string{SegmentNewAccount, SegmentProlific, SegmentCollector, SegmentRegular}
+	index := make(map[string]int)
+	rs := ReviewerStats{}
+	for i, segment := range order {
+		index[segment] = i
+		rs.Segments = append(rs.Segments, ReviewerSegment{Segment: segment})
+	}
+
+	hours := make([][]float64, len(order))
+	sentiment := make([]float64, len(order))
+	for _, review := range reviews {
+		i := index[ReviewerSegmentOf(review.Author)]
+		s := &rs.Segments[i]
+		s.Total++
+		if review.VotedUp {
+			s.Positive++
+		}
+		if review.SentimentScore != nil {
+			s.Scored++
+			sentiment[i] += *review.SentimentScore
+		}
+		hours[i] = append(hours[i], float64(review.Author.PlaytimeAtReview)/60)
+	}
+
+	for i := range rs.Segments {
+		s := &rs.Segments[i]
+		s.PositiveRatio = percent(s.Positive, s.Total)
+		s.Share = percent(s.Total, len(reviews))
+		s.MedianPlaytimeHours = median(hours[i])
+		if s.Scored > 0 {
+			s.MeanSentiment = sentiment[i] / float64(s.Scored)
+		}
+	}
+
+	rs.SuspiciousClusters = findSuspiciousClusters(reviews)
+	return rs
+}
+
+// findSuspiciousClusters 不審なレビューが suspiciousWindow 内に suspiciousMinClusterSize 件以上集中している期間を探す
+func findSuspiciousClusters(reviews []models.ReviewData) []SuspiciousCluster {
+	var suspects []models.ReviewData
+	var created []int64
+	for _, review := range reviews {
+		if review.TimestampCreated > 0 {
+			created = append(created, review.TimestampCreated)
+		}
+		if isSuspiciousReviewer(review) {
+			suspects = append(suspects, review)
+		}
+	}
+	sort.Slice(suspects, func(i, j int) bool {
+		return suspects[i].TimestampCreated < suspects[j].TimestampCreated
+	})
+
+	window := int64(suspiciousWindow / time.Second)
+	var clusters []SuspiciousCluster
+	for i := 0; i < len(suspects); {
+		j := i
+		for j < len(suspects) && suspects[j].TimestampCreated-suspects[i].TimestampCreated <= window {
+			j++
+		}
+		if j-i < suspiciousMinClusterSize {
+			i++
+			continue
+		}
+
+		first, last := suspects[i].TimestampCreated, suspects[j-1].TimestampCreated
+		c := SuspiciousCluster{
+			Start: time.Unix(first, 0).UTC().Format("2006-01-02 15:04"),
+			End:   time.Unix(last, 0).UTC().Format("2006-01-02 15:04"),
+			Count: j - i,
+		}
+		for _, review := range suspects[i:j] {
+			if review.VotedUp {
+				c.Positive++
+			}
+			c.RecommendationIDs = append(c.RecommendationIDs, review.RecommendationID)
+		}
+		for _, t := range created {
+			if t >= first && t <= last {
+				c.WindowTotal++
+			}
+		}
+		c.PositiveRatio = percent(c.Positive, c.Count)
+		clusters = append(clusters, c)
+		i = j
+	}
+	return clusters
+}
+
+// printReviewerStats 投稿者プロフィール別の統計を表示
+func printReviewerStats(w io.Writer, rs ReviewerStats) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgStatsReviewerTitle))
+
+	labels := map[string]string{
+		SegmentNewAccount: i18n.Tf(i18n.MsgStatsReviewerNewAccount, newAccountMaxGames, newAccountMaxReviews),
+		SegmentProlific:   i18n.Tf(i18n.MsgStatsReviewerProlific, prolificMinReviews),
+		SegmentCollector:  i18n.Tf(i18n.MsgStatsReviewerCollector, collectorMinGames),
+		SegmentRegular:    i18n.T(i18n.MsgStatsReviewerRegular),
+	}
+	for _, s := range rs.Segments {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsReviewerSegment,
+			labels[s.Segment], s.Total, s.Share, s.PositiveRatio, s.MedianPlaytimeHours))
+		if s.Scored > 0 {
+			fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSourceSentiment, s.MeanSentiment, s.Scored))
+		}
+	}
+
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSuspiciousTitle,
+		suspiciousMaxPlaytimeMinutes, suspiciousMaxGames, suspiciousMinClusterSize, int(suspiciousWindow.Hours())))
+	if len(rs.SuspiciousClusters) == 0 {
+		fmt.Fprintln(w, i18n.T(i18n.MsgStatsSuspiciousNone))
+		return
+	}
+	for _, c := range rs.SuspiciousClusters {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSuspiciousCluster,
+			c.Start, c.End, c.Count, c.WindowTotal, c.PositiveRatio))
+	}
+}
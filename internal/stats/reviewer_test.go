@@ -0,0 +1,72 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestReviewerSegmentOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		author   models.AuthorData
+		expected string
+	}{
+		{"New account", models.AuthorData{NumGamesOwned: 2, NumReviews: 1}, SegmentNewAccount},
+		{"Private profile", models.AuthorData{NumGamesOwned: 0, NumReviews: 0}, SegmentNewAccount},
+		{"Few games but many reviews", models.AuthorData{NumGamesOwned: 3, NumReviews: 80}, SegmentProlific},
+		{"Collector", models.AuthorData{NumGamesOwned: 1200, NumReviews: 10}, SegmentCollector},
+		{"Regular", models.AuthorData{NumGamesOwned: 150, NumReviews: 10}, SegmentRegular},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := ReviewerSegmentOf(tt.author); result != tt.expected {
+				t.Errorf("ReviewerSegmentOf() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComputeReviewerStats(t *testing.T) {
+	base := int64(1700000000)
+	var reviews []models.ReviewData
+	// 1時間おきに投稿された少所持・プレイ時間0のアカウントによる肯定的レビュー
+	for i := 0; i < 6; i++ {
+		reviews = append(reviews, models.ReviewData{
+			RecommendationID: string(rune('a' + i)),
+			VotedUp:          true,
+			TimestampCreated: base + int64(i)*3600,
+			Author:           models.AuthorData{NumGamesOwned: 1},
+		})
+	}
+	// 同じ期間の通常のレビュー
+	reviews = append(reviews, models.ReviewData{
+		TimestampCreated: base + 1800,
+		Author:           models.AuthorData{NumGamesOwned: 200, NumReviews: 10, PlaytimeAtReview: 600},
+	})
+	// 期間外の少数の不審レビューはクラスタにならない
+	for i := 0; i < 3; i++ {
+		reviews = append(reviews, models.ReviewData{
+			TimestampCreated: base + 30*24*3600 + int64(i),
+			Author:           models.AuthorData{NumGamesOwned: 1},
+		})
+	}
+
+	rs := ComputeReviewerStats(reviews)
+
+	if len(rs.Segments) != 4 || rs.Segments[0].Segment != SegmentNewAccount || rs.Segments[0].Total != 9 {
+		t.Fatalf("Segments = %+v", rs.Segments)
+	}
+	if rs.Segments[3].Total != 1 || rs.Segments[3].MedianPlaytimeHours != 10 {
+		t.Errorf("regular segment = %+v", rs.Segments[3])
+	}
+
+	if len(rs.SuspiciousClusters) != 1 {
+		t.Fatalf("len(SuspiciousClusters) = %d, want 1", len(rs.SuspiciousClusters))
+	}
+	c := rs.SuspiciousClusters[0]
+	if c.Count != 6 || c.WindowTotal != 7 || c.PositiveRatio != 100 || len(c.RecommendationIDs) != 6 {
+		t.Errorf("cluster = %+v", c)
+	}
+}
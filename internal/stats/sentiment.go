@@ -0,0 +1,133 @@
+package stats
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// DefaultMismatchThreshold 評価と本文の感情が食い違うと判定する感情スコアの閾値
+const DefaultMismatchThreshold = 0.3
+
+// maxMismatchedShown 表示する食い違いレビューの最大件数
+const maxMismatchedShown = 10
+
+// SentimentBucket 感情スコアの区分
+type SentimentBucket struct {
+	Label string  `json:"label"` // 表示用ラベル
+	Max   float64 `json:"max"`   // 上限（この値を含む）
+	Count int     `json:"count"` // 該当するレビュー数
+}
+
+// SentimentStats 本文の感情スコアの統計
+type SentimentStats struct {
+	Scored       int                 `json:"scored"`        // 感情スコアが付与されたレビュー数
+	Mean         float64             `json:"mean"`          // 平均スコア
+	MeanPositive float64             `json:"mean_positive"` // 肯定的レビューの平均スコア
+	MeanNegative float64             `json:"mean_negative"` // 否定的レビューの平均スコア
+	Buckets      []SentimentBucket   `json:"buckets"`       // スコアの分布
+	Mismatched   []models.ReviewData `json:"mismatched"`    // 評価と本文の感情が食い違うレビュー（食い違いの大きい順）
+}
+
+// newSentimentBuckets 感情スコアの区分を作成
+func newSentimentBuckets() []SentimentBucket {
+	return []SentimentBucket{
+		{Label: "very negative", Max: -0.5},
+		{Label: "negative", Max: -0.05},
+		{Label: "neutral", Max: 0.05},
+		{Label: "positive", Max: 0.5},
+		{Label: "very positive", Max: 1},
+	}
+}
+
+// ComputeSentimentStats レビューに付与された感情スコアを集計
+//
+// SentimentScore が nil のレビューは集計対象外。thresholdは食い違いと判定するスコアの絶対値。
+func ComputeSentimentStats(reviews []models.ReviewData, threshold float64) SentimentStats {
+	ss := SentimentStats{Buckets: newSentimentBuckets()}
+	var sum, sumPositive, sumNegative float64
+	var positive, negative int
+
+	for _, review := range reviews {
+		if review.SentimentScore == nil {
+			continue
+		}
+		score := *review.SentimentScore
+		ss.Scored++
+		sum += score
+		if review.VotedUp {
+			positive++
+			sumPositive += score
+			if score <= -threshold {
+				ss.Mismatched = append(ss.Mismatched, review)
+			}
+		} else {
+			negative++
+			sumNegative += score
+			if score >= threshold {
+				ss.Mismatched = append(ss.Mismatched, review)
+			}
+		}
+
+		for i := range ss.Buckets {
+			if score <= ss.Buckets[i].Max || i == len(ss.Buckets)-1 {
+				ss.Buckets[i].Count++
+				break
+			}
+		}
+	}
+
+	if ss.Scored > 0 {
+		ss.Mean = sum / float64(ss.Scored)
+	}
+	if positive > 0 {
+		ss.MeanPositive = sumPositive / float64(positive)
+	}
+	if negative > 0 {
+		ss.MeanNegative = sumNegative / float64(negative)
+	}
+
+	sort.SliceStable(ss.Mismatched, func(i, j int) bool {
+		return math.Abs(*ss.Mismatched[i].SentimentScore) > math.Abs(*ss.Mismatched[j].SentimentScore)
+	})
+
+	return ss
+}
+
+// snippet レビュー本文を1行に縮めて先頭のみ返す
+func snippet(s string, maxRunes int) string {
+	s = strings.Join(strings.Fields(s), " ")
+	runes := []rune(s)
+	if len(runes) <= maxRunes {
+		return s
+	}
+	return string(runes[:maxRunes]) + "..."
+}
+
+// printSentimentStats 感情スコアの統計を表示
+func printSentimentStats(w io.Writer, ss SentimentStats, total int) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgStatsSentimentTitle))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSentimentScored, ss.Scored, percent(ss.Scored, total)))
+	if ss.Scored == 0 {
+		return
+	}
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSentimentMean, ss.Mean, ss.MeanPositive, ss.MeanNegative))
+	for _, b := range ss.Buckets {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSentimentBucket, b.Label, b.Count, percent(b.Count, ss.Scored)))
+	}
+
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSentimentMismatched, len(ss.Mismatched)))
+	for i, review := range ss.Mismatched {
+		if i >= maxMismatchedShown {
+			break
+		}
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSentimentMismatchItem,
+			review.RecommendationID, review.VotedUp, *review.SentimentScore, snippet(review.Review, 60)))
+	}
+}
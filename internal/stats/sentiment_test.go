@@ -0,0 +1,33 @@
+package stats
+
+import (
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestComputeSentimentStats(t *testing.T) {
+	score := func(v float64) *float64 { return &v }
+	reviews := []models.ReviewData{
+		{RecommendationID: "1", VotedUp: true, SentimentScore: score(0.75)},
+		{RecommendationID: "2", VotedUp: true, SentimentScore: score(-0.5)}, // 食い違い
+		{RecommendationID: "3", VotedUp: false, SentimentScore: score(-0.7)},
+		{RecommendationID: "4", VotedUp: false, SentimentScore: score(0.9)}, // 食い違い
+		{RecommendationID: "5", VotedUp: false},                             // スコアなし
+	}
+
+	ss := ComputeSentimentStats(reviews, DefaultMismatchThreshold)
+
+	if ss.Scored != 4 {
+		t.Errorf("Scored = %d, want 4", ss.Scored)
+	}
+	if ss.MeanPositive != 0.125 {
+		t.Errorf("MeanPositive = %v, want 0.125", ss.MeanPositive)
+	}
+	if len(ss.Mismatched) != 2 || ss.Mismatched[0].RecommendationID != "4" {
+		t.Errorf("Mismatched = %+v, want [4 2]", ss.Mismatched)
+	}
+	if ss.Buckets[0].Count != 2 || ss.Buckets[4].Count != 2 {
+		t.Errorf("Buckets = %+v, want 2 very negative and 2 very positive", ss.Buckets)
+	}
+}
@@ -0,0 +1,156 @@
+package stats
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// minSignificanceSample 有意差を判定するのに必要な各グループの最小レビュー数
+const minSignificanceSample = 10
+
+// SegmentGroup 比較するグループごとの集計
+type SegmentGroup struct {
+	Label         string  `json:"label"`
+	Total         int     `json:"total"`
+	Positive      int     `json:"positive"`
+	PositiveRatio float64 `json:"positive_ratio"` // 肯定的レビューの割合 (%)
+	Scored        int     `json:"scored"`         // 感情スコアのあるレビュー数
+	MeanSentiment float64 `json:"mean_sentiment"` // 感情スコアの平均（Scored が0の場合は0）
+}
+
+// SegmentComparison 2つのグループの肯定的割合の比較
+type SegmentComparison struct {
+	A           SegmentGroup `json:"a"`
+	B           SegmentGroup `json:"b"`
+	Difference  float64      `json:"difference"`  // 肯定的割合の差 A-B (%ポイント)
+	ZScore      float64      `json:"z_score"`     // 2標本比率のz検定統計量
+	PValue      float64      `json:"p_value"`     // 両側p値
+	Sufficient  bool         `json:"sufficient"`  // 両グループとも判定に十分なレビュー数があるか
+	Significant bool         `json:"significant"` // 5%水準で有意な差があるか
+}
+
+// SourceStats 早期アクセス・購入経路別の統計
+type SourceStats struct {
+	EarlyAccess SegmentComparison `json:"early_access"` // 早期アクセス中 vs 正式リリース後
+	Purchase    SegmentComparison `json:"purchase"`     // Steamで購入 vs キー有効化など
+	FreeCopy    SegmentComparison `json:"free_copy"`    // 無料で入手 vs それ以外
+}
+
+// TwoProportionZTest 2標本の比率の差のz検定（プールした分散を使用）を行い、z値と両側p値を返す
+func TwoProportionZTest(positiveA, totalA, positiveB, totalB int) (z, p float64) {
+	if totalA == 0 || totalB == 0 {
+		return 0, 1
+	}
+	nA, nB := float64(totalA), float64(totalB)
+	pooled := float64(positiveA+positiveB) / (nA + nB)
+	se := math.Sqrt(pooled * (1 - pooled) * (1/nA + 1/nB))
+	if se == 0 {
+		return 0, 1
+	}
+	z = (float64(positiveA)/nA - float64(positiveB)/nB) / se
+	p = math.Erfc(math.Abs(z) / math.Sqrt2)
+	return z, p
+}
+
+// compareSegments 条件を満たすレビュー (A) とそれ以外 (B) を比較
+func compareSegments(reviews []models.ReviewData, labelA, labelB string, inA func(models.ReviewData) bool) SegmentComparison {
+	sc := SegmentComparison{
+		A: SegmentGroup{Label: labelA},
+		B: SegmentGroup{Label: labelB},
+	}
+	var sentimentA, sentimentB float64
+	for _, review := range reviews {
+		g, sum := &sc.B, &sentimentB
+		if inA(review) {
+			g, sum = &sc.A, &sentimentA
+		}
+		g.Total++
+		if review.VotedUp {
+			g.Positive++
+		}
+		if review.SentimentScore != nil {
+			g.Scored++
+			*sum += *review.SentimentScore
+		}
+	}
+
+	for _, item := range []struct {
+		g   *SegmentGroup
+		sum float64
+	}{{&sc.A, sentimentA}, {&sc.B, sentimentB}} {
+		item.g.PositiveRatio = percent(item.g.Positive, item.g.Total)
+		if item.g.Scored > 0 {
+			item.g.MeanSentiment = item.sum / float64(item.g.Scored)
+		}
+	}
+
+	sc.Difference = sc.A.PositiveRatio - sc.B.PositiveRatio
+	sc.ZScore, sc.PValue = TwoProportionZTest(sc.A.Positive, sc.A.Total, sc.B.Positive, sc.B.Total)
+	sc.Sufficient = sc.A.Total >= minSignificanceSample && sc.B.Total >= minSignificanceSample
+	sc.Significant = sc.Sufficient && sc.PValue < 0.05
+	return sc
+}
+
+// ComputeSourceStats 早期アクセス・購入経路・無料入手別の統計を計算
+func ComputeSourceStats(reviews []models.ReviewData) SourceStats {
+	return SourceStats{
+		EarlyAccess: compareSegments(reviews, "early_access", "released",
+			func(r models.ReviewData) bool { return r.WrittenDuringEA }),
+		Purchase: compareSegments(reviews, "steam_purchase", "other_source",
+			func(r models.ReviewData) bool { return r.SteamPurchase }),
+		FreeCopy: compareSegments(reviews, "received_for_free", "paid",
+			func(r models.ReviewData) bool { return r.ReceivedForFree }),
+	}
+}
+
+// significanceHint 有意差の判定結果を表示用の文字列で返す
+func significanceHint(sc SegmentComparison) string {
+	switch {
+	case !sc.Sufficient:
+		return i18n.Tf(i18n.MsgStatsSignificanceInsufficient, minSignificanceSample)
+	case sc.PValue < 0.01:
+		return i18n.T(i18n.MsgStatsSignificanceStrong)
+	case sc.Significant:
+		return i18n.T(i18n.MsgStatsSignificanceWeak)
+	default:
+		return i18n.T(i18n.MsgStatsSignificanceNone)
+	}
+}
+
+// printSourceStats 早期アクセス・購入経路別の統計を表示
+func printSourceStats(w io.Writer, ss SourceStats) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgStatsSourceTitle))
+
+	sections := []struct {
+		title  string
+		labelA string
+		labelB string
+		sc     SegmentComparison
+	}{
+		{i18n.T(i18n.MsgStatsSourceEarlyAccess), i18n.T(i18n.MsgStatsSourceEALabel), i18n.T(i18n.MsgStatsSourceReleasedLabel), ss.EarlyAccess},
+		{i18n.T(i18n.MsgStatsSourcePurchase), i18n.T(i18n.MsgStatsSourceSteamLabel), i18n.T(i18n.MsgStatsSourceOtherLabel), ss.Purchase},
+		{i18n.T(i18n.MsgStatsSourceFreeCopy), i18n.T(i18n.MsgStatsSourceFreeLabel), i18n.T(i18n.MsgStatsSourcePaidLabel), ss.FreeCopy},
+	}
+	for _, section := range sections {
+		fmt.Fprintln(w, section.title)
+		for _, g := range []struct {
+			label string
+			group SegmentGroup
+		}{{section.labelA, section.sc.A}, {section.labelB, section.sc.B}} {
+			fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSourceGroup, g.label, g.group.Total, g.group.Positive, g.group.PositiveRatio))
+			if g.group.Scored > 0 {
+				fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSourceSentiment, g.group.MeanSentiment, g.group.Scored))
+			}
+		}
+		if section.sc.A.Total == 0 || section.sc.B.Total == 0 {
+			continue
+		}
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsSourceDifference,
+			section.sc.Difference, section.sc.ZScore, section.sc.PValue, significanceHint(section.sc)))
+	}
+}
@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+func TestTwoProportionZTest(t *testing.T) {
+	tests := []struct {
+		name                                 string
+		positiveA, totalA, positiveB, totalB int
+		expectedZ, expectedP                 float64
+	}{
+		{"Different", 80, 100, 60, 100, 3.086, 0.002},
+		{"Equal", 50, 100, 50, 100, 0, 1},
+		{"Empty group", 5, 10, 0, 0, 0, 1},
+		{"All positive", 10, 10, 20, 20, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			z, p := TwoProportionZTest(tt.positiveA, tt.totalA, tt.positiveB, tt.totalB)
+			if math.Abs(z-tt.expectedZ) > 0.001 || math.Abs(p-tt.expectedP) > 0.001 {
+				t.Errorf("TwoProportionZTest() = (%.3f, %.3f), want (%.3f, %.3f)", z, p, tt.expectedZ, tt.expectedP)
+			}
+		})
+	}
+}
+
+func TestComputeSourceStats(t *testing.T) {
+	score := 0.5
+	var reviews []models.ReviewData
+	for i := 0; i < 20; i++ {
+		// 無料入手のレビューはすべて肯定的、有料は半数が肯定的
+		reviews = append(reviews, models.ReviewData{VotedUp: true, ReceivedForFree: true, SentimentScore: &score})
+		reviews = append(reviews, models.ReviewData{VotedUp: i%2 == 0, SteamPurchase: true})
+	}
+	reviews = append(reviews, models.ReviewData{VotedUp: false, WrittenDuringEA: true})
+
+	ss := ComputeSourceStats(reviews)
+
+	free := ss.FreeCopy
+	if free.A.Total != 20 || free.A.PositiveRatio != 100 || free.B.Total != 21 || free.B.Positive != 10 {
+		t.Errorf("FreeCopy = %+v", free)
+	}
+	if free.A.Scored != 20 || free.A.MeanSentiment != 0.5 || free.B.Scored != 0 {
+		t.Errorf("FreeCopy sentiment = %+v", free)
+	}
+	if !free.Sufficient || !free.Significant || free.Difference <= 0 {
+		t.Errorf("FreeCopy should be a significant positive difference: %+v", free)
+	}
+
+	if ea := ss.EarlyAccess; ea.A.Total != 1 || ea.Sufficient || ea.Significant {
+		t.Errorf("EarlyAccess with one review should not be judged: %+v", ea)
+	}
+	if ss.Purchase.A.Total != 20 || ss.Purchase.B.Total != 21 {
+		t.Errorf("Purchase = %+v", ss.Purchase)
+	}
+}
@@ -1,61 +1,222 @@
 package stats
 
 import (
+	"fmt"
+	"io"
+	"sort"
+
 	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/pkg/config"
 	"github.com/y-moriya/steam-review/pkg/i18n"
 )
 
-// Logger インターフェース（ロガーの依存性注入のため）
-type Logger interface {
-	Println(v ...interface{})
-	Printf(format string, v ...interface{})
+// Options 統計表示のオプション
+type Options struct {
+	PlaytimeBuckets []int          // プレイ時間区分の境界（時間単位）
+	Keywords        bool           // キーワード分析を表示する
+	KeywordOptions  KeywordOptions // キーワード分析のオプション
+
+	Sentiment         bool    // 感情スコアの統計を表示する
+	MismatchThreshold float64 // 評価と本文の感情が食い違うと判定する閾値
+
+	TopHelpful int // 表示する最も有用な肯定的・否定的レビューの件数
 }
 
-// PrintReviewStats レビュー統計を表示
-func PrintReviewStats(reviews []models.ReviewData, gameName string, logger Logger) {
-	if len(reviews) == 0 {
-		logger.Println(i18n.T(i18n.MsgStatsNoReviews))
-		return
+// DefaultOptions デフォルトの統計オプションを返す
+func DefaultOptions() Options {
+	return Options{
+		PlaytimeBuckets: DefaultPlaytimeBuckets,
+		KeywordOptions:  DefaultKeywordOptions(),
+
+		MismatchThreshold: DefaultMismatchThreshold,
+
+		TopHelpful: DefaultTopHelpful,
+	}
+}
+
+// LanguageStats 言語別の統計
+type LanguageStats struct {
+	Language      string  `json:"language"`
+	ISO           string  `json:"iso,omitempty"`  // 言語のISO/BCP 47 タグ
+	Name          string  `json:"name,omitempty"` // 英語の言語名（表示言語によらず固定。表示時は Print で翻訳する）
+	Total         int     `json:"total"`
+	Positive      int     `json:"positive"`
+	Negative      int     `json:"negative"`
+	Share         float64 `json:"share"`          // 全体に占める割合 (%)
+	PositiveRatio float64 `json:"positive_ratio"` // 肯定的レビューの割合 (%)
+}
+
+// ReviewStats レビュー統計の計算結果
+type ReviewStats struct {
+	GameName          string                 `json:"game_name"`
+	TotalReviews      int                    `json:"total_reviews"`
+	Positive          int                    `json:"positive"`
+	Negative          int                    `json:"negative"`
+	PositiveRatio     float64                `json:"positive_ratio"`
+	NegativeRatio     float64                `json:"negative_ratio"`
+	Languages         []LanguageStats        `json:"languages"` // レビュー数の多い順
+	Timeline          Timeline               `json:"timeline"`
+	Playtime          PlaytimeStats          `json:"playtime"`
+	DeveloperResponse DeveloperResponseStats `json:"developer_response"`
+	Helpfulness       HelpfulnessStats       `json:"helpfulness"`
+	Sources           SourceStats            `json:"sources"`
+	Reviewers         ReviewerStats          `json:"reviewers"`
+	Length            LengthStats            `json:"length"`
+	Sentiment         *SentimentStats        `json:"sentiment,omitempty"` // Options.Sentiment が有効な場合のみ
+	Keywords          *KeywordStats          `json:"keywords,omitempty"`  // Options.Keywords が有効な場合のみ
+}
+
+// Compute レビュー統計を計算
+func Compute(reviews []models.ReviewData, gameName string, opts Options) ReviewStats {
+	rs := ReviewStats{
+		GameName:     gameName,
+		TotalReviews: len(reviews),
 	}
 
-	totalReviews := len(reviews)
-	positiveReviews := 0
 	languageCounts := make(map[string]int)
 	languagePositive := make(map[string]int)
 
 	for _, review := range reviews {
-		if review.VotedUp {
-			positiveReviews++
-		}
 		lang := review.Language
 		if lang == "" {
 			lang = "unknown"
 		}
 		languageCounts[lang]++
 		if review.VotedUp {
+			rs.Positive++
 			languagePositive[lang]++
 		}
 	}
 
-	negativeReviews := totalReviews - positiveReviews
-	positivePercent := float64(positiveReviews) / float64(totalReviews) * 100
-	negativePercent := float64(negativeReviews) / float64(totalReviews) * 100
-
-	logger.Println()
-	logger.Println(i18n.T(i18n.MsgStatsTitle))
-	logger.Printf(i18n.Tf(i18n.MsgStatsGame, gameName))
-	logger.Printf(i18n.Tf(i18n.MsgStatsTotalReviews, totalReviews))
-	logger.Printf(i18n.Tf(i18n.MsgStatsPositive, positiveReviews, positivePercent))
-	logger.Printf(i18n.Tf(i18n.MsgStatsNegative, negativeReviews, negativePercent))
+	rs.Negative = rs.TotalReviews - rs.Positive
+	rs.PositiveRatio = percent(rs.Positive, rs.TotalReviews)
+	rs.NegativeRatio = percent(rs.Negative, rs.TotalReviews)
 
-	logger.Println()
-	logger.Println(i18n.T(i18n.MsgStatsLanguageBreakdown))
 	for lang, count := range languageCounts {
 		positive := languagePositive[lang]
-		negative := count - positive
-		percent := float64(count) / float64(totalReviews) * 100
-		positiveRate := float64(positive) / float64(count) * 100
-		logger.Printf(i18n.Tf(i18n.MsgFileLanguageStats,
-			lang, count, percent, positive, positiveRate, negative))
+		rs.Languages = append(rs.Languages, LanguageStats{
+			Language:      lang,
+			ISO:           config.LanguageISO(lang),
+			Name:          languageName(lang),
+			Total:         count,
+			Positive:      positive,
+			Negative:      count - positive,
+			Share:         percent(count, rs.TotalReviews),
+			PositiveRatio: percent(positive, count),
+		})
+	}
+	sort.Slice(rs.Languages, func(i, j int) bool {
+		if rs.Languages[i].Total != rs.Languages[j].Total {
+			return rs.Languages[i].Total > rs.Languages[j].Total
+		}
+		return rs.Languages[i].Language < rs.Languages[j].Language
+	})
+
+	rs.Timeline = ComputeTimeline(reviews)
+	rs.Playtime = ComputePlaytimeStats(reviews, opts.PlaytimeBuckets)
+	rs.DeveloperResponse = ComputeDeveloperResponseStats(reviews)
+	rs.Helpfulness = ComputeHelpfulnessStats(reviews, opts.TopHelpful)
+	rs.Sources = ComputeSourceStats(reviews)
+	rs.Reviewers = ComputeReviewerStats(reviews)
+	rs.Length = ComputeLengthStats(reviews)
+	if opts.Sentiment {
+		ss := ComputeSentimentStats(reviews, opts.MismatchThreshold)
+		rs.Sentiment = &ss
+	}
+	if opts.Keywords {
+		ks := ComputeKeywordStats(reviews, opts.KeywordOptions)
+		rs.Keywords = &ks
+	}
+
+	return rs
+}
+
+// PrintReviewStats レビュー統計を表示
+func PrintReviewStats(w io.Writer, reviews []models.ReviewData, gameName string) {
+	PrintReviewStatsWithOptions(w, reviews, gameName, DefaultOptions())
+}
+
+// PrintReviewStatsWithOptions オプションを指定してレビュー統計を表示
+func PrintReviewStatsWithOptions(w io.Writer, reviews []models.ReviewData, gameName string, opts Options) {
+	Print(w, Compute(reviews, gameName, opts))
+}
+
+// Print 計算済みのレビュー統計を表示
+func Print(w io.Writer, rs ReviewStats) {
+	if rs.TotalReviews == 0 {
+		fmt.Fprintln(w, i18n.T(i18n.MsgStatsNoReviews))
+		return
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgStatsTitle))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsGame, rs.GameName))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsTotalReviews, rs.TotalReviews))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsPositive, rs.Positive, rs.PositiveRatio))
+	fmt.Fprintln(w, i18n.Tf(i18n.MsgStatsNegative, rs.Negative, rs.NegativeRatio))
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, i18n.T(i18n.MsgStatsLanguageBreakdown))
+	for _, ls := range rs.Languages {
+		fmt.Fprintln(w, i18n.Tf(i18n.MsgFileLanguageStats,
+			config.LanguageLabel(ls.Language), ls.Total, ls.Share, ls.Positive, ls.PositiveRatio, ls.Negative))
+	}
+
+	printPlaytimeStats(w, rs.Playtime, rs.TotalReviews)
+	printDeveloperResponseStats(w, rs.DeveloperResponse)
+	printHelpfulnessStats(w, rs.Helpfulness)
+	printSourceStats(w, rs.Sources)
+	printReviewerStats(w, rs.Reviewers)
+	printLengthStats(w, rs.Length)
+	if rs.Sentiment != nil {
+		printSentimentStats(w, *rs.Sentiment, rs.TotalReviews)
+	}
+	if rs.Keywords != nil {
+		printKeywordStats(w, *rs.Keywords)
+	}
+}
+
+// languageName 言語コードの英語名（カタログにない言語コードは空文字列）
+//
+// JSON・CSVの出力が表示言語 (-locale) で変わらないように、翻訳した名前は使わない。
+func languageName(code string) string {
+	if lang, ok := config.LookupLanguage(code); ok {
+		return lang.NameEN
+	}
+	return ""
+}
+
+// percent 割合をパーセントで計算（分母が0の場合は0）
+func percent(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(part) / float64(total) * 100
+}
+
+// mean 平均を計算（空の場合は0）
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
+
+// median 中央値を計算（空の場合は0）
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
 	}
+	return sorted[mid]
 }
@@ -0,0 +1,105 @@
+package stats
+
+import (
+	"time"
+
+	"github.com/y-moriya/steam-review/internal/models"
+)
+
+// 時系列集計の期間単位
+const (
+	PeriodDay   = "day"
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+)
+
+// TimelinePoint 期間ごとのレビュー数
+type TimelinePoint struct {
+	Start    string `json:"start"` // 期間の開始日 (YYYY-MM-DD, UTC)
+	Total    int    `json:"total"`
+	Positive int    `json:"positive"`
+}
+
+// Timeline 投稿日時によるレビュー数の推移
+type Timeline struct {
+	Period string          `json:"period"` // 期間単位 (day, week, month)
+	Points []TimelinePoint `json:"points"` // 古い順（レビューのない期間も0件として含む）
+}
+
+// periodStart 指定した期間単位での開始時刻を返す（週は月曜始まり）
+func periodStart(t time.Time, period string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case PeriodWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case PeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// nextPeriod 次の期間の開始時刻を返す
+func nextPeriod(t time.Time, period string) time.Time {
+	switch period {
+	case PeriodWeek:
+		return t.AddDate(0, 0, 7)
+	case PeriodMonth:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+// ComputeTimeline 投稿日時からレビュー数の推移を計算
+//
+// 期間単位は全体の期間に応じて自動的に選択する（90日以内は日, 2年以内は週, それ以上は月）。
+func ComputeTimeline(reviews []models.ReviewData) Timeline {
+	var first, last time.Time
+	for _, review := range reviews {
+		if review.TimestampCreated <= 0 {
+			continue
+		}
+		t := time.Unix(review.TimestampCreated, 0).UTC()
+		if first.IsZero() || t.Before(first) {
+			first = t
+		}
+		if last.IsZero() || t.After(last) {
+			last = t
+		}
+	}
+	if first.IsZero() {
+		return Timeline{Period: PeriodDay}
+	}
+
+	period := PeriodMonth
+	switch span := last.Sub(first); {
+	case span <= 90*24*time.Hour:
+		period = PeriodDay
+	case span <= 2*365*24*time.Hour:
+		period = PeriodWeek
+	}
+
+	tl := Timeline{Period: period}
+	index := make(map[string]int)
+	for t := periodStart(first, period); !t.After(last); t = nextPeriod(t, period) {
+		key := t.Format("2006-01-02")
+		index[key] = len(tl.Points)
+		tl.Points = append(tl.Points, TimelinePoint{Start: key})
+	}
+
+	for _, review := range reviews {
+		if review.TimestampCreated <= 0 {
+			continue
+		}
+		key := periodStart(time.Unix(review.TimestampCreated, 0).UTC(), period).Format("2006-01-02")
+		p := &tl.Points[index[key]]
+		p.Total++
+		if review.VotedUp {
+			p.Positive++
+		}
+	}
+
+	return tl
+}
@@ -1,10 +1,15 @@
 package storage
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"log"
+	"io"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,101 +25,308 @@ func SaveReviewsToFile(reviews []models.ReviewData, filename string, outputJSON
 
 // SaveReviewsToFileWithGameDetails ゲーム詳細情報付きでレビューをファイルに保存
 func SaveReviewsToFileWithGameDetails(reviews []models.ReviewData, filename string, outputJSON bool, gameDetails *models.GameDetails) (string, error) {
+	return SaveReviewsToFileWithMetadata(reviews, filename, outputJSON, gameDetails, nil)
+}
+
+// SaveReviewsToFileWithMetadata ゲーム詳細情報と取得に関する情報付きでレビューをファイルに保存
+//
+// metadata が nil の場合は SaveReviewsToFileWithGameDetails と同じ内容になる。
+func SaveReviewsToFileWithMetadata(reviews []models.ReviewData, filename string, outputJSON bool, gameDetails *models.GameDetails, metadata *models.FetchMetadata) (string, error) {
 	file, err := os.Create(filename)
 	if err != nil {
 		return "", fmt.Errorf(i18n.T(i18n.MsgFileCreationError), err)
 	}
-	defer file.Close()
 
+	if err := WriteReviews(file, reviews, outputJSON, gameDetails, metadata); err != nil {
+		file.Close()
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf(i18n.T(i18n.MsgFileWriteError), err)
+	}
+	return filename, nil
+}
+
+// WriteReviews レビューをテキスト形式またはJSON形式で w に書き込む（SaveReviewsToFileWithMetadata と同じ内容）
+func WriteReviews(w io.Writer, reviews []models.ReviewData, outputJSON bool, gameDetails *models.GameDetails, metadata *models.FetchMetadata) error {
 	if !outputJSON {
+		// 書き込みエラーは bufio.Writer に保持され、Flush で返される
+		w := bufio.NewWriter(w)
+
+		// 取得が完了していない場合は先頭に明記
+		if metadata != nil && metadata.Incomplete {
+			fmt.Fprintf(w, "%s\n\n", i18n.Tf(i18n.MsgFileIncomplete, len(reviews), metadata.FetchedAt.Format("2006-01-02 15:04:05")))
+		}
+
 		// ゲーム詳細情報をテキストヘッダーとして追加
 		if gameDetails != nil {
-			fmt.Fprintf(file, "%s\n", i18n.T(i18n.MsgFileGameDetails))
-			fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileGameName, gameDetails.Name))
-			fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileAppID, gameDetails.AppID))
-			if len(gameDetails.Developer) > 0 {
-				fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileDeveloper, strings.Join(gameDetails.Developer, ", ")))
-			}
-			if len(gameDetails.Publisher) > 0 {
-				fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFilePublisher, strings.Join(gameDetails.Publisher, ", ")))
-			}
-			fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileReleaseDate, gameDetails.ReleaseDate))
-			fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFilePrice, gameDetails.Price))
-			if len(gameDetails.Genres) > 0 {
-				fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileGenres, strings.Join(gameDetails.Genres, ", ")))
-			}
-			if len(gameDetails.Categories) > 0 {
-				fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileCategories, strings.Join(gameDetails.Categories, ", ")))
-			}
-			if gameDetails.Website != "" {
-				fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileWebsite, gameDetails.Website))
-			}
-			fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileAgeRestriction, gameDetails.RequiredAge))
-			fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileFree, gameDetails.IsFree))
-			fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileRetrievedAt, gameDetails.RetrievedAt.Format("2006-01-02 15:04:05")))
-			fmt.Fprintf(file, "\n%s\n\n", i18n.T(i18n.MsgFileReviewsList))
+			WriteGameDetails(w, gameDetails)
+			fmt.Fprintf(w, "\n%s\n\n", i18n.T(i18n.MsgFileReviewsList))
 		}
 
 		// テキスト形式で保存
 		for i, review := range reviews {
-			fmt.Fprintf(file, "%s\n", i18n.Tf(i18n.MsgFileReviewNumber, i+1))
-			fmt.Fprintf(file, "ID: %s\n", review.RecommendationID)
-			fmt.Fprintf(file, "language: %s\n", review.Language)
-			fmt.Fprintf(file, "voted_up: ")
+			fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileReviewNumber, i+1))
+			fmt.Fprintf(w, "ID: %s\n", review.RecommendationID)
+			fmt.Fprintf(w, "language: %s\n", review.Language)
+			fmt.Fprintf(w, "voted_up: ")
 			if review.VotedUp {
-				fmt.Fprintf(file, "true\n")
+				fmt.Fprintf(w, "true\n")
 			} else {
-				fmt.Fprintf(file, "false\n")
+				fmt.Fprintf(w, "false\n")
+			}
+			fmt.Fprintf(w, "votes_up: %d\n", review.VotesUp)
+			fmt.Fprintf(w, "votes_funny: %d\n", review.VotesFunny)
+			fmt.Fprintf(w, "weighted_score: %.2f\n", review.WeightedScore)
+			fmt.Fprintf(w, "steam_purchase: %t\n", review.SteamPurchase)
+			fmt.Fprintf(w, "playtime: %d分\n", review.Author.PlaytimeAtReview)
+			if review.SentimentScore != nil {
+				fmt.Fprintf(w, "sentiment_score: %.3f\n", *review.SentimentScore)
 			}
-			fmt.Fprintf(file, "votes_up: %d\n", review.VotesUp)
-			fmt.Fprintf(file, "votes_funny: %d\n", review.VotesFunny)
-			fmt.Fprintf(file, "weighted_score: %.2f\n", review.WeightedScore)
-			fmt.Fprintf(file, "steam_purchase: %t\n", review.SteamPurchase)
-			fmt.Fprintf(file, "playtime: %d分\n", review.Author.PlaytimeAtReview)
-			fmt.Fprintf(file, "created_at: %s\n", time.Unix(review.TimestampCreated, 0).Format("2006-01-02 15:04:05"))
+			fmt.Fprintf(w, "created_at: %s\n", time.Unix(review.TimestampCreated, 0).Format("2006-01-02 15:04:05"))
 			if review.TimestampUpdated > 0 {
-				fmt.Fprintf(file, "updated_at: %s\n", time.Unix(review.TimestampUpdated, 0).Format("2006-01-02 15:04:05"))
+				fmt.Fprintf(w, "updated_at: %s\n", time.Unix(review.TimestampUpdated, 0).Format("2006-01-02 15:04:05"))
 			}
-			fmt.Fprintf(file, "review:\n%s\n", review.Review)
+			fmt.Fprintf(w, "review:\n%s\n", review.Review)
 			if review.DeveloperResponse != "" {
-				fmt.Fprintf(file, "developer_response:\n%s\n", review.DeveloperResponse)
+				fmt.Fprintf(w, "developer_response:\n%s\n", review.DeveloperResponse)
 				if review.TimestampDevResponse > 0 {
-					fmt.Fprintf(file, "developer_response_timestamp: %s\n", time.Unix(review.TimestampDevResponse, 0).Format("2006-01-02 15:04:05"))
+					fmt.Fprintf(w, "developer_response_timestamp: %s\n", time.Unix(review.TimestampDevResponse, 0).Format("2006-01-02 15:04:05"))
 				}
 			}
-			fmt.Fprintf(file, "\n")
+			fmt.Fprintf(w, "\n")
+		}
+		if err := w.Flush(); err != nil {
+			return fmt.Errorf(i18n.T(i18n.MsgFileWriteError), err)
 		}
 	} else {
 		// JSON形式で保存
 		type OutputData struct {
-			GameDetails *models.GameDetails `json:"game_details,omitempty"`
-			Reviews     []models.ReviewData `json:"reviews"`
+			Metadata    *models.FetchMetadata `json:"metadata,omitempty"`
+			GameDetails *models.GameDetails   `json:"game_details,omitempty"`
+			Reviews     []models.ReviewData   `json:"reviews"`
 		}
 
 		outputData := OutputData{
+			Metadata:    metadata,
 			GameDetails: gameDetails,
 			Reviews:     reviews,
 		}
 
-		encoder := json.NewEncoder(file)
+		encoder := json.NewEncoder(w)
 		encoder.SetIndent("", "  ")
 
 		if err := encoder.Encode(outputData); err != nil {
-			return "", fmt.Errorf(i18n.T(i18n.MsgFileJSONWriteError), err)
+			return fmt.Errorf(i18n.T(i18n.MsgFileJSONWriteError), err)
 		}
 	}
+	return nil
+}
 
+// WriteGameDetails ゲーム詳細情報をテキスト形式で書き込む
+func WriteGameDetails(w io.Writer, gd *models.GameDetails) {
+	fmt.Fprintf(w, "%s\n", i18n.T(i18n.MsgFileGameDetails))
+	fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileGameName, gd.Name))
+	fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileAppID, gd.AppID))
+	if len(gd.Developer) > 0 {
+		fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileDeveloper, strings.Join(gd.Developer, ", ")))
+	}
+	if len(gd.Publisher) > 0 {
+		fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFilePublisher, strings.Join(gd.Publisher, ", ")))
+	}
+	fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileReleaseDate, gd.ReleaseDate))
+	fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFilePrice, gd.Price))
+	if len(gd.Genres) > 0 {
+		fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileGenres, strings.Join(gd.Genres, ", ")))
+	}
+	if len(gd.Categories) > 0 {
+		fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileCategories, strings.Join(gd.Categories, ", ")))
+	}
+	if gd.Website != "" {
+		fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileWebsite, gd.Website))
+	}
+	fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileAgeRestriction, gd.RequiredAge))
+	fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileFree, gd.IsFree))
+	fmt.Fprintf(w, "%s\n", i18n.Tf(i18n.MsgFileRetrievedAt, gd.RetrievedAt.Format("2006-01-02 15:04:05")))
+}
+
+// SaveReviewsToCSV レビューを1件1行のCSV形式でファイルに保存
+func SaveReviewsToCSV(reviews []models.ReviewData, filename string) (string, error) {
+	file, err := os.Create(filename)
+	if err != nil {
+		return "", fmt.Errorf(i18n.T(i18n.MsgFileCreationError), err)
+	}
+
+	if err := WriteReviewsCSV(file, reviews); err != nil {
+		file.Close()
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf(i18n.T(i18n.MsgFileCSVWriteError), err)
+	}
 	return filename, nil
 }
 
+// WriteReviewsCSV レビューを1件1行のCSV形式で w に書き込む
+func WriteReviewsCSV(w io.Writer, reviews []models.ReviewData) error {
+	writer := csv.NewWriter(w)
+	header := []string{
+		"recommendation_id", "language", "language_iso", "voted_up", "votes_up", "votes_funny", "weighted_vote_score",
+		"steam_purchase", "received_for_free", "written_during_early_access", "playtime_at_review",
+		"num_games_owned", "num_reviews", "sentiment_score", "timestamp_created", "timestamp_updated",
+		"review", "developer_response",
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf(i18n.T(i18n.MsgFileCSVWriteError), err)
+	}
+
+	for _, review := range reviews {
+		sentimentScore := ""
+		if review.SentimentScore != nil {
+			sentimentScore = strconv.FormatFloat(*review.SentimentScore, 'f', 3, 64)
+		}
+		record := []string{
+			review.RecommendationID,
+			review.Language,
+			config.LanguageISO(review.Language),
+			strconv.FormatBool(review.VotedUp),
+			strconv.Itoa(review.VotesUp),
+			strconv.Itoa(review.VotesFunny),
+			strconv.FormatFloat(review.WeightedScore, 'f', 6, 64),
+			strconv.FormatBool(review.SteamPurchase),
+			strconv.FormatBool(review.ReceivedForFree),
+			strconv.FormatBool(review.WrittenDuringEA),
+			strconv.Itoa(review.Author.PlaytimeAtReview),
+			strconv.Itoa(review.Author.NumGamesOwned),
+			strconv.Itoa(review.Author.NumReviews),
+			sentimentScore,
+			strconv.FormatInt(review.TimestampCreated, 10),
+			strconv.FormatInt(review.TimestampUpdated, 10),
+			review.Review,
+			review.DeveloperResponse,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf(i18n.T(i18n.MsgFileCSVWriteError), err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf(i18n.T(i18n.MsgFileCSVWriteError), err)
+	}
+	return nil
+}
+
+// WriteReviewsJSONL レビューを1件1行のJSON (JSON Lines) 形式で w に書き込む
+func WriteReviewsJSONL(w io.Writer, reviews []models.ReviewData) error {
+	encoder := json.NewEncoder(w)
+	for _, review := range reviews {
+		if err := encoder.Encode(review); err != nil {
+			return fmt.Errorf(i18n.T(i18n.MsgFileJSONWriteError), err)
+		}
+	}
+	return nil
+}
+
+// LoadReviewsFromFile JSON形式で保存したレビューファイルを読み込む
+//
+// 対応する形式は LoadReviews と同じ。
+func LoadReviewsFromFile(filename string) ([]models.ReviewData, *models.GameDetails, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, nil, fmt.Errorf(i18n.T(i18n.MsgFileReadError), err)
+	}
+	return decodeReviews(data)
+}
+
+// LoadReviews r からレビューを読み込む
+//
+// SaveReviewsToFileWithGameDetails が出力するオブジェクト形式、レビューの配列のみの形式、
+// 1行に1件のレビューを書いた JSON Lines 形式に対応する。
+func LoadReviews(r io.Reader) ([]models.ReviewData, *models.GameDetails, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf(i18n.T(i18n.MsgFileReadError), err)
+	}
+	return decodeReviews(data)
+}
+
+// decodeReviews LoadReviews の形式のデータからレビューを取り出す
+func decodeReviews(data []byte) ([]models.ReviewData, *models.GameDetails, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, nil, nil
+	}
+
+	if data[0] == '[' {
+		var reviews []models.ReviewData
+		if err := json.Unmarshal(data, &reviews); err != nil {
+			return nil, nil, fmt.Errorf(i18n.T(i18n.MsgFileJSONReadError), err)
+		}
+		return reviews, nil, nil
+	}
+
+	// "reviews" を含む1つのオブジェクトであれば保存したファイルの形式
+	var outputData struct {
+		GameDetails *models.GameDetails  `json:"game_details"`
+		Reviews     *[]models.ReviewData `json:"reviews"`
+	}
+	if err := json.Unmarshal(data, &outputData); err == nil && outputData.Reviews != nil {
+		return *outputData.Reviews, outputData.GameDetails, nil
+	}
+
+	// それ以外は JSON Lines 形式として1件ずつ読み込む
+	var reviews []models.ReviewData
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for {
+		var review models.ReviewData
+		if err := decoder.Decode(&review); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, fmt.Errorf(i18n.T(i18n.MsgFileJSONReadError), err)
+		}
+		reviews = append(reviews, review)
+	}
+	return reviews, nil, nil
+}
+
+// AllLanguages 言語別に保存する場合の全言語のファイル名に付ける言語名
+const AllLanguages = "all_languages"
+
+// LanguageFilename 言語別に保存する場合の言語 lang のファイル名
+func LanguageFilename(baseFilename, outputDir, lang string, outputJSON bool) string {
+	ext := config.FileExtTXT
+	if outputJSON {
+		ext = config.FileExtJSON
+	}
+	filename := strings.TrimSuffix(baseFilename, config.FileExtJSON) + "_" + lang + ext
+	if outputDir != "" {
+		filename = outputDir + "/" + filename
+	}
+	return filename
+}
+
+// Logger 言語別に保存したファイルを記録する詳細ログ（nil の場合は記録しない。属性は language, file）
+type Logger interface {
+	Debug(msg string, args ...any)
+}
+
 // SaveReviewsByLanguage レビューを言語別に分けてファイルに保存
-func SaveReviewsByLanguage(reviews []models.ReviewData, baseFilename, outputDir string, verbose bool, outputJSON bool) ([]string, error) {
-	return SaveReviewsByLanguageWithGameDetails(reviews, baseFilename, outputDir, verbose, outputJSON, nil)
+func SaveReviewsByLanguage(reviews []models.ReviewData, baseFilename, outputDir string, logger Logger, outputJSON bool) ([]string, error) {
+	return SaveReviewsByLanguageWithGameDetails(reviews, baseFilename, outputDir, logger, outputJSON, nil)
 }
 
 // SaveReviewsByLanguageWithGameDetails ゲーム詳細情報付きでレビューを言語別に分けてファイルに保存
-func SaveReviewsByLanguageWithGameDetails(reviews []models.ReviewData, baseFilename, outputDir string, verbose bool, outputJSON bool, gameDetails *models.GameDetails) ([]string, error) {
+func SaveReviewsByLanguageWithGameDetails(reviews []models.ReviewData, baseFilename, outputDir string, logger Logger, outputJSON bool, gameDetails *models.GameDetails) ([]string, error) {
+	return SaveReviewsByLanguageWithMetadata(reviews, baseFilename, outputDir, logger, outputJSON, gameDetails, nil)
+}
+
+// SaveReviewsByLanguageWithMetadata ゲーム詳細情報と取得に関する情報付きでレビューを言語別に分けてファイルに保存
+//
+// 一部の言語のファイルを保存できなかった場合も残りのファイルを保存し、保存したファイルとともにそのエラーを返す。
+func SaveReviewsByLanguageWithMetadata(reviews []models.ReviewData, baseFilename, outputDir string, logger Logger, outputJSON bool, gameDetails *models.GameDetails, metadata *models.FetchMetadata) ([]string, error) {
 	var savedFiles []string
+	var errs []error
 	// 言語別にレビューを分類
 	reviewsByLanguage := make(map[string][]models.ReviewData)
 
@@ -126,44 +338,32 @@ func SaveReviewsByLanguageWithGameDetails(reviews []models.ReviewData, baseFilen
 		reviewsByLanguage[lang] = append(reviewsByLanguage[lang], review)
 	}
 
-	// ファイル拡張子を決定
-	ext := ".txt"
-	if outputJSON {
-		ext = ".json"
-	}
-
 	// 言語別にファイル保存
 	for lang, langReviews := range reviewsByLanguage {
-		filename := strings.TrimSuffix(baseFilename, config.FileExtJSON) + "_" + lang + ext
-		if outputDir != "" {
-			filename = outputDir + "/" + filename
-		}
+		filename := LanguageFilename(baseFilename, outputDir, lang, outputJSON)
 
-		if savedFile, err := SaveReviewsToFileWithGameDetails(langReviews, filename, outputJSON, gameDetails); err != nil {
-			log.Printf(i18n.T(i18n.MsgFileLanguageSaveError), lang, err)
+		if savedFile, err := SaveReviewsToFileWithMetadata(langReviews, filename, outputJSON, gameDetails, metadata); err != nil {
+			errs = append(errs, errors.New(i18n.Tf(i18n.MsgFileLanguageSaveError, lang, err)))
 			continue
 		} else {
 			savedFiles = append(savedFiles, savedFile)
-			if verbose {
-				log.Printf(i18n.T(i18n.MsgFileLanguageSaved), lang, len(langReviews), filename)
+			if logger != nil {
+				logger.Debug(i18n.Tf(i18n.MsgFileLanguageSaved, lang, len(langReviews), filename), "language", lang, "file", filename)
 			}
 		}
 	}
 
 	// 全体のサマリーも保存
-	summaryFilename := strings.TrimSuffix(baseFilename, config.FileExtJSON) + "_all_languages" + ext
-	if outputDir != "" {
-		summaryFilename = outputDir + "/" + summaryFilename
-	}
+	summaryFilename := LanguageFilename(baseFilename, outputDir, AllLanguages, outputJSON)
 
-	if savedFile, err := SaveReviewsToFileWithGameDetails(reviews, summaryFilename, outputJSON, gameDetails); err != nil {
-		return nil, fmt.Errorf(i18n.T(i18n.MsgFileSummaryError), err)
+	if savedFile, err := SaveReviewsToFileWithMetadata(reviews, summaryFilename, outputJSON, gameDetails, metadata); err != nil {
+		errs = append(errs, fmt.Errorf(i18n.T(i18n.MsgFileSummaryError), err))
 	} else {
 		savedFiles = append(savedFiles, savedFile)
-		if verbose {
-			log.Printf(i18n.T(i18n.MsgFileAllLanguagesSaved), summaryFilename, len(reviews))
+		if logger != nil {
+			logger.Debug(i18n.Tf(i18n.MsgFileAllLanguagesSaved, summaryFilename, len(reviews)), "file", summaryFilename)
 		}
 	}
 
-	return savedFiles, nil
+	return savedFiles, errors.Join(errs...)
 }
@@ -0,0 +1,31 @@
+package term
+
+import (
+	"os"
+	"strconv"
+)
+
+// DefaultWidth 端末幅が取得できない場合の幅
+const DefaultWidth = 80
+
+// IsTerminal ファイルが端末（TTY）に接続されているか
+func IsTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// Width 端末の幅（桁数）を返す
+//
+// COLUMNS 環境変数、端末への問い合わせ、DefaultWidth の順に決定する。
+func Width(f *os.File) int {
+	if cols, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && cols > 0 {
+		return cols
+	}
+	if cols := terminalWidth(f); cols > 0 {
+		return cols
+	}
+	return DefaultWidth
+}
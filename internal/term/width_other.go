@@ -0,0 +1,10 @@
+//go:build !(linux || darwin || freebsd || netbsd || openbsd)
+
+package term
+
+import "os"
+
+// terminalWidth 端末の幅を取得できないプラットフォームでは0を返す
+func terminalWidth(f *os.File) int {
+	return 0
+}
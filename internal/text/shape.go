@@ -0,0 +1,90 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// アスキーアート判定の閾値
+const (
+	artMinLineRunes   = 8   // 判定対象とする行の最小文字数（空白を除く）
+	artMinSymbolRatio = 0.7 // 行を絵とみなす記号文字の割合
+	artMinLines       = 3   // アスキーアートとみなす絵の行数
+)
+
+// StripBBCode BBCodeタグを除去したテキストを返す
+func StripBBCode(s string) string {
+	return bbcodePattern.ReplaceAllString(s, "")
+}
+
+// RuneCount BBCodeタグと前後の空白を除いた文字数（バイト数ではなく文字単位）を返す
+func RuneCount(s string) int {
+	return utf8.RuneCountInString(strings.TrimSpace(StripBBCode(s)))
+}
+
+// WordCount BBCodeタグを除いた単語数を返す
+//
+// 空白区切りの言語は空白・句読点で区切られた語を、CJKの文字は1文字を1語として数える。
+func WordCount(s string) int {
+	count := 0
+	inWord := false
+	for _, r := range StripBBCode(s) {
+		switch {
+		case IsCJK(r):
+			count++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'':
+			if !inWord {
+				count++
+				inWord = true
+			}
+		default:
+			inWord = false
+		}
+	}
+	return count
+}
+
+// IsOneLine 改行を含まない1行のテキストかどうか（前後の空白は無視）
+func IsOneLine(s string) bool {
+	return !strings.Contains(strings.TrimSpace(StripBBCode(s)), "\n")
+}
+
+// HasURL URLを含むかどうか
+func HasURL(s string) bool {
+	return urlPattern.MatchString(s)
+}
+
+// isArtRune アスキーアートに使われる記号文字か（文字・数字・空白・一般的な句読点以外）
+func isArtRune(r rune) bool {
+	if unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r) {
+		return false
+	}
+	return !strings.ContainsRune(".,!?'\"、。！？「」()（）", r)
+}
+
+// HasASCIIArt アスキーアート（罫線・ブロック・点字文字などの記号が大半を占める行が複数続くもの）を含むかどうか
+func HasASCIIArt(s string) bool {
+	artLines := 0
+	for _, line := range strings.Split(StripBBCode(s), "\n") {
+		total, symbols := 0, 0
+		for _, r := range line {
+			// 点字の空白 (U+2800) はアスキーアートの背景として使われる
+			if unicode.IsSpace(r) && r != '⠀' {
+				continue
+			}
+			total++
+			if isArtRune(r) {
+				symbols++
+			}
+		}
+		if total >= artMinLineRunes && float64(symbols)/float64(total) >= artMinSymbolRatio {
+			artLines++
+			if artLines >= artMinLines {
+				return true
+			}
+		}
+	}
+	return false
+}
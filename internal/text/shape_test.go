@@ -0,0 +1,61 @@
+package text
+
+import "testing"
+
+func TestRuneCountAndWordCount(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expectedRunes int
+		expectedWords int
+	}{
+		{"English", "Great game, don't miss it!", 26, 5},
+		{"Japanese", "最高に楽しい", 6, 6},
+		{"Mixed", "[b]神ゲー[/b] 10/10", 9, 5},
+		{"Whitespace", "  \n ok \n", 2, 1},
+		{"Empty", "", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := RuneCount(tt.input); result != tt.expectedRunes {
+				t.Errorf("RuneCount(%q) = %d, want %d", tt.input, result, tt.expectedRunes)
+			}
+			if result := WordCount(tt.input); result != tt.expectedWords {
+				t.Errorf("WordCount(%q) = %d, want %d", tt.input, result, tt.expectedWords)
+			}
+		})
+	}
+}
+
+func TestShape(t *testing.T) {
+	art := "look at this\n⣿⣿⣿⣿⠿⠿⣿⣿⣿⣿\n⣿⣿⠏⠀⠀⠀⠀⠹⣿⣿\n⣿⣿⣄⠀⠀⠀⠀⣠⣿⣿\n"
+	boxes := "☐ Bad\n☐ Ok\n☑ Good\n"
+	tests := []struct {
+		name     string
+		input    string
+		oneLine  bool
+		hasURL   bool
+		asciiArt bool
+	}{
+		{"One line", "  Fun  \n", true, false, false},
+		{"URL", "See https://example.com\nfor details", false, true, false},
+		{"Braille art", art, false, false, true},
+		{"Short checklist is not art", boxes, false, false, false},
+		{"Punctuation is not art", "!!!!!!!!!!\n??????????\n..........", false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := IsOneLine(tt.input); result != tt.oneLine {
+				t.Errorf("IsOneLine() = %t, want %t", result, tt.oneLine)
+			}
+			if result := HasURL(tt.input); result != tt.hasURL {
+				t.Errorf("HasURL() = %t, want %t", result, tt.hasURL)
+			}
+			if result := HasASCIIArt(tt.input); result != tt.asciiArt {
+				t.Errorf("HasASCIIArt() = %t, want %t", result, tt.asciiArt)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package text
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// englishStopwords 英語のデフォルトストップワード
+var englishStopwords = []string{
+	"a", "about", "after", "again", "all", "also", "am", "an", "and", "any", "are", "as", "at",
+	"be", "because", "been", "before", "being", "but", "by", "can", "could", "did", "do", "does",
+	"doing", "don't", "for", "from", "get", "got", "had", "has", "have", "he", "her", "here", "him",
+	"his", "how", "i", "i'm", "i've", "if", "in", "into", "is", "it", "it's", "its", "just", "me",
+	"more", "my", "of", "on", "one", "only", "or", "other", "our", "out", "over", "really", "so",
+	"some", "than", "that", "that's", "the", "their", "them", "then", "there", "these", "they",
+	"this", "those", "to", "too", "up", "us", "very", "was", "we", "were", "what", "when", "where",
+	"which", "while", "who", "will", "with", "would", "you", "you're", "your",
+	"game", "games", "play", "played", "playing",
+}
+
+// cjkStopwords 日本語・中国語のデフォルトストップワード（文字バイグラム）
+var cjkStopwords = []string{
+	"です", "ます", "した", "して", "しま", "ませ", "でし", "この", "その", "これ", "それ", "あり",
+	"ある", "いる", "する", "った", "って", "ので", "から", "けど", "ても", "でも", "ては", "には",
+	"とは", "のは", "のが", "まし", "ゲー", "ーム", "てい", "いま",
+	"的是", "我们", "这个", "一个", "游戏", "遊戲", "沒有", "没有",
+}
+
+// DefaultStopwords デフォルトのストップワード集合を返す
+func DefaultStopwords() map[string]bool {
+	stopwords := make(map[string]bool, len(englishStopwords)+len(cjkStopwords))
+	for _, w := range englishStopwords {
+		stopwords[w] = true
+	}
+	for _, w := range cjkStopwords {
+		stopwords[w] = true
+	}
+	return stopwords
+}
+
+// LoadStopwords ファイルからストップワードを読み込み、既存の集合に追加する
+//
+// ファイルは1行1語で、空行と "#" で始まる行は無視する。
+func LoadStopwords(path string, stopwords map[string]bool) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		stopwords[strings.ToLower(line)] = true
+	}
+	return scanner.Err()
+}
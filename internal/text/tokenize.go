@@ -0,0 +1,143 @@
+package text
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	// bbcodePattern Steamレビューで使われるBBCodeタグ ([b], [/url], [url=...] など)
+	bbcodePattern = regexp.MustCompile(`\[/?[a-zA-Z0-9*]+(=[^\]]*)?\]`)
+	// urlPattern URL
+	urlPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+)
+
+// Clean BBCodeタグとURLを除去したテキストを返す
+func Clean(s string) string {
+	s = bbcodePattern.ReplaceAllString(s, " ")
+	s = urlPattern.ReplaceAllString(s, " ")
+	return s
+}
+
+// IsCJK 空白で単語が区切られない文字（漢字・ひらがな・カタカナ）かどうか
+func IsCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) || r == 'ー'
+}
+
+// Segments テキストを句読点や記号で区切られたセグメントに分割し、各セグメントを単語列として返す
+//
+// 空白区切りの言語は小文字化した単語に、日本語・中国語のように空白で区切られない
+// 文字の連続は文字バイグラム（1文字のみの場合はその文字）に分割する。
+func Segments(s string) [][]string {
+	var segments [][]string
+	var current []string
+	var word []rune
+	var cjk []rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			current = append(current, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			current = append(current, string(cjk))
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			current = append(current, string(cjk[i:i+2]))
+		}
+		cjk = cjk[:0]
+	}
+	flushSegment := func() {
+		flushWord()
+		flushCJK()
+		if len(current) > 0 {
+			segments = append(segments, current)
+			current = nil
+		}
+	}
+
+	for _, r := range Clean(s) {
+		switch {
+		case IsCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '\'':
+			if len(cjk) > 0 {
+				// CJK文字と英数字が連続する場合は別セグメントとして扱う
+				flushSegment()
+			}
+			word = append(word, r)
+		case unicode.IsSpace(r):
+			flushWord()
+			flushCJK()
+		default:
+			flushSegment()
+		}
+	}
+	flushSegment()
+
+	return segments
+}
+
+// Tokens テキストを単語（CJKは文字バイグラム）に分割
+func Tokens(s string) []string {
+	var tokens []string
+	for _, segment := range Segments(s) {
+		tokens = append(tokens, segment...)
+	}
+	return tokens
+}
+
+// Bigrams 同じセグメント内で隣接する2語を連結したバイグラムを返す
+//
+// CJKの文字バイグラム同士は重なり部分をまとめて文字トライグラムとして連結する。
+// stopwordsに含まれる語を含むバイグラムは除外する。
+func Bigrams(s string, stopwords map[string]bool) []string {
+	var bigrams []string
+	for _, segment := range Segments(s) {
+		for i := 0; i+1 < len(segment); i++ {
+			a, b := segment[i], segment[i+1]
+			if stopwords[a] || stopwords[b] || !isTerm(a) || !isTerm(b) {
+				continue
+			}
+			ar, br := []rune(a), []rune(b)
+			if IsCJK(ar[len(ar)-1]) && IsCJK(br[0]) {
+				if len(ar) == 2 && len(br) == 2 && ar[1] == br[0] {
+					bigrams = append(bigrams, a+string(br[1]))
+				}
+				continue
+			}
+			bigrams = append(bigrams, a+" "+b)
+		}
+	}
+	return bigrams
+}
+
+// Terms テキストからストップワードを除いた単語（CJKは文字バイグラム）を返す
+func Terms(s string, stopwords map[string]bool) []string {
+	var terms []string
+	for _, token := range Tokens(s) {
+		if stopwords[token] || !isTerm(token) {
+			continue
+		}
+		terms = append(terms, token)
+	}
+	return terms
+}
+
+// isTerm 集計対象とする語か（数字のみや1文字の英字は除外）
+func isTerm(token string) bool {
+	runes := []rune(token)
+	if len(runes) == 1 && !IsCJK(runes[0]) {
+		return false
+	}
+	for _, r := range runes {
+		if !unicode.IsDigit(r) {
+			return true
+		}
+	}
+	return false
+}
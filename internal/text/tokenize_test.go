@@ -0,0 +1,92 @@
+package text
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokens(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "English words",
+			input:    "Great Game, don't miss it!",
+			expected: []string{"great", "game", "don't", "miss", "it"},
+		},
+		{
+			name:     "Japanese character bigrams",
+			input:    "最高に楽しい",
+			expected: []string{"最高", "高に", "に楽", "楽し", "しい"},
+		},
+		{
+			name:     "Single CJK character",
+			input:    "神",
+			expected: []string{"神"},
+		},
+		{
+			name:     "BBCode and URL removed",
+			input:    "[b]Bad[/b] see https://example.com/page",
+			expected: []string{"bad", "see"},
+		},
+		{
+			name:     "Mixed scripts",
+			input:    "DLCが高い",
+			expected: []string{"dlc", "が高", "高い"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Tokens(tt.input)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Tokens(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTerms(t *testing.T) {
+	stopwords := map[string]bool{"the": true}
+	result := Terms("The 2 bosses are 100% fun", stopwords)
+	expected := []string{"bosses", "are", "fun"}
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("Terms() = %q, want %q", result, expected)
+	}
+}
+
+func TestBigrams(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "English bigrams within a segment",
+			input:    "great story. bad ending",
+			expected: []string{"great story", "bad ending"},
+		},
+		{
+			name:     "Stopwords break bigrams",
+			input:    "the story is great",
+			expected: nil,
+		},
+		{
+			name:     "Japanese character trigrams",
+			input:    "神ゲー",
+			expected: []string{"神ゲー"},
+		},
+	}
+
+	stopwords := map[string]bool{"the": true, "is": true}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Bigrams(tt.input, stopwords)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Bigrams(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
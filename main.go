@@ -1,20 +1,152 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"text/tabwriter"
 
-	"github.com/y-moriya/steam-review/internal/api"
 	"github.com/y-moriya/steam-review/internal/logger"
-	"github.com/y-moriya/steam-review/internal/models"
-	"github.com/y-moriya/steam-review/internal/stats"
-	"github.com/y-moriya/steam-review/internal/storage"
 	"github.com/y-moriya/steam-review/pkg/config"
 	"github.com/y-moriya/steam-review/pkg/i18n"
 )
 
+// 終了コード（README と使用方法の「終了コード」に記載しているため、値を変更しないこと）
+const (
+	exitOK       = 0 // 正常終了
+	exitError    = 1 // 以下に分類されない実行時のエラー（ファイルの読み込みエラーなど）
+	exitUsage    = 2 // コマンドや引数の指定の誤り
+	exitNotFound = 3 // 指定した名前のゲームが見つからない
+	exitNetwork  = 4 // Steam API との通信の失敗
+	exitWrite    = 5 // ファイル・ディレクトリの書き込みの失敗
+	exitPartial  = 6 // 複数のゲームのうち一部の取得・保存に失敗（残りのゲームは保存済み）
+
+	exitInterrupted = 130 // SIGINT・SIGTERM による中断（取得済みのレビューは不完全として保存）
+)
+
+// stdinArg ファイル名の代わりに指定すると標準入力から読み込む（export -file では標準出力に書き出す）引数
+const stdinArg = "-"
+
+// usageError コマンドや引数の指定の誤り（終了コード exitUsage で終了する）
+type usageError struct {
+	err error
+}
+
+func (e usageError) Error() string {
+	return e.err.Error()
+}
+
+// newUsageError メッセージから usageError を作成
+func newUsageError(msg string) error {
+	return usageError{err: errors.New(msg)}
+}
+
+// loggedError ロガーで出力済みのエラー（run では再表示しない）
+type loggedError struct {
+	err error
+}
+
+func (e loggedError) Error() string {
+	return e.err.Error()
+}
+
+func (e loggedError) Unwrap() error {
+	return e.err
+}
+
+// interruptedError シグナルによる中断（終了コード exitInterrupted で終了する）
+type interruptedError struct {
+	err error
+}
+
+func (e interruptedError) Error() string {
+	return e.err.Error()
+}
+
+func (e interruptedError) Unwrap() error {
+	return e.err
+}
+
+// failureError 終了コードを分類した実行時のエラー
+type failureError struct {
+	code int
+	err  error
+}
+
+func (e failureError) Error() string {
+	return e.err.Error()
+}
+
+func (e failureError) Unwrap() error {
+	return e.err
+}
+
+// newFailure 終了コード code で終了するエラーを作成
+func newFailure(code int, err error) error {
+	return failureError{code: code, err: err}
+}
+
+// wrapFailure err の終了コードを引き継いで、msg をメッセージとするエラーを作成
+func wrapFailure(err error, msg string) error {
+	var fe failureError
+	if errors.As(err, &fe) {
+		return failureError{code: fe.code, err: errors.New(msg)}
+	}
+	return errors.New(msg)
+}
+
+// logError エラーをロガーで出力し、loggedError として返す（出力済みの場合はそのまま返す）
+func logError(log *logger.Logger, err error) error {
+	var le loggedError
+	if errors.As(err, &le) {
+		return err
+	}
+	log.Error(err.Error())
+	return loggedError{err: err}
+}
+
+// command サブコマンドの定義
+type command struct {
+	name    string
+	summary string                                         // 一覧に表示する説明のメッセージキー
+	usage   string                                         // 使用方法のメッセージキー
+	run     func(ctx context.Context, args []string) error // ctx は SIGINT・SIGTERM でキャンセルされる
+}
+
+// commands サブコマンドの一覧
+func commands() []command {
+	return []command{
+		{"fetch", i18n.MsgCommandFetch, i18n.MsgUsageFetch, runFetch},
+		{"stats", i18n.MsgCommandStats, i18n.MsgUsageStats, runStats},
+		{"search", i18n.MsgCommandSearch, i18n.MsgUsageSearch, runSearch},
+		{"export", i18n.MsgCommandExport, i18n.MsgUsageExport, runExport},
+		{"details", i18n.MsgCommandDetails, i18n.MsgUsageDetails, runDetails},
+		{"compare", i18n.MsgCommandCompare, i18n.MsgUsageCompare, runCompare},
+		{"watch", i18n.MsgCommandWatch, i18n.MsgUsageWatch, runWatch},
+		{"config", i18n.MsgCommandConfig, i18n.MsgUsageConfig, runConfig},
+		{"completion", i18n.MsgCommandCompletion, i18n.MsgUsageCompletion, runCompletion},
+		{"version", i18n.MsgCommandVersion, i18n.MsgUsageVersion, runVersion},
+		{"help", i18n.MsgCommandHelp, i18n.MsgUsageHelpCmd, runHelp},
+	}
+}
+
+// findCommand 名前からサブコマンドを検索
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands() {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+	return command{}, false
+}
+
 // ParseLanguages カンマ区切りの言語文字列を配列に変換
 func ParseLanguages(langStr string) []string {
 	var languages []string
@@ -27,161 +159,315 @@ func ParseLanguages(langStr string) []string {
 	return languages
 }
 
-// printUsage 使用方法を表示
-func printUsage() {
-	fmt.Printf(i18n.T(i18n.MsgUsageFull), config.AppName, config.Version)
+// isAppID 文字列が数字のみで構成されるApp IDかどうかを判定
+func isAppID(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
 }
 
-func main() {
-	// i18n システムを初期化
-	i18n.Init()
+// registerGlobalFlags すべてのサブコマンドで共通のフラグを登録
+func registerGlobalFlags(fs *flag.FlagSet, cfg *config.Config) {
+	fs.BoolVar(&cfg.Verbose, "verbose", false, "詳細なログを表示")
+	fs.BoolVar(&cfg.Quiet, "quiet", false, "エラー以外のメッセージと進捗を表示しない (統計などの結果は表示する)")
+	fs.StringVar(&cfg.LogLevel, "log-level", config.LogLevelInfo, "出力するログの最低レベル (debug, info, warn, error。-verbose は debug と同じ)")
+	fs.StringVar(&cfg.LogFormat, "log-format", config.LogFormatText, "ログの形式 (text, json)")
+	fs.StringVar(&cfg.OutputDir, "output", "output", "出力ディレクトリ")
+	fs.StringVar(&cfg.Locale, "locale", "", "表示言語 (en, ja。デフォルト: 環境変数から判定)")
+	fs.StringVar(&cfg.ConfigFile, "config", "", "設定ファイル (デフォルト: ./steam-review.toml, ユーザー設定ディレクトリの順に検索)")
+	fs.StringVar(&cfg.Profile, "profile", "", "設定ファイルのプロファイル名")
+}
 
-	var cfg config.Config
-	var languageStr string
-	var help bool
-	var showVersion bool
-
-	// コマンドライン引数の定義
-	flag.BoolVar(&showVersion, "version", false, "バージョン情報を表示")
-	flag.StringVar(&cfg.AppID, "appid", "", "Steam App ID")
-	flag.StringVar(&cfg.GameName, "game", "", "ゲーム名")
-	flag.IntVar(&cfg.MaxReviews, "max", 100, "最大取得レビュー数 (0で無制限)")
-	flag.StringVar(&languageStr, "lang", "japanese", "取得する言語 (カンマ区切り, デフォルト: japanese)")
-	flag.StringVar(&cfg.OutputDir, "output", "output", "出力ディレクトリ")
-	flag.StringVar(&cfg.Filter, "filter", config.FilterAll,
-		"レビューのフィルター (recent: 作成日時順, updated: 更新日時順, all: 有用性順(デフォルト))")
-	flag.BoolVar(&cfg.SplitByLang, "split", false, "言語別にファイルを分けて保存")
-	flag.BoolVar(&cfg.OutputJSON, "json", false, "出力ファイルをJSON形式(.json)にする (デフォルト: テキスト形式)")
-	flag.BoolVar(&cfg.Verbose, "verbose", false, "詳細なログを表示")
-	flag.BoolVar(&help, "help", false, "ヘルプを表示")
-
-	flag.Parse()
-
-	// バージョン情報の表示
-	if showVersion {
-		fmt.Printf("%s\n", i18n.Tf(i18n.MsgAppVersion, config.Version))
-		return
+// applyLocale -locale で指定された表示言語を適用
+func applyLocale(locale string) error {
+	if locale == "" {
+		return nil
 	}
-
-	// ヘルプ表示
-	if help {
-		printUsage()
-		return
+	if !i18n.SetLanguage(locale) {
+		return newUsageError(i18n.Tf(i18n.MsgErrorLocale, locale))
 	}
+	return nil
+}
+
+// newFlagSet サブコマンド用のフラグセットを作成（共通フラグを含む）
+func newFlagSet(name string, cfg *config.Config) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Usage = func() {}
+	registerGlobalFlags(fs, cfg)
+	return fs
+}
+
+// parseFlags フラグを解析し、設定ファイルと表示言語を適用（-help の場合は使用方法を表示して flag.ErrHelp を返す）
+func parseFlags(fs *flag.FlagSet, args []string, cfg *config.Config) error {
+	_, err := parseFlagsWithSources(fs, args, cfg)
+	return err
+}
 
-	// ロガーを初期化
-	log, err := logger.New("logs", cfg.Verbose)
+// parseFlagsWithSources parseFlags と同様にフラグを解析し、各フラグの値の取得元を返す
+func parseFlagsWithSources(fs *flag.FlagSet, args []string, cfg *config.Config) (map[string]string, error) {
+	if flagSetCollector != nil {
+		flagSetCollector(fs)
+		return nil, errFlagsCollected
+	}
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			applyLocale(cfg.Locale)
+			printCommandUsage(fs.Name(), fs)
+			return nil, err
+		}
+		return nil, usageError{err: err}
+	}
+	// 設定ファイルや環境変数のエラーも指定された表示言語で表示する（不正な値は後で報告する）
+	applyLocale(cfg.Locale)
+	sources, err := applyConfigFile(fs, cfg)
 	if err != nil {
-		fmt.Printf("%s\n", i18n.Tf(i18n.MsgErrorLoggerInit, err))
-		os.Exit(1)
+		return nil, err
 	}
-	defer log.Close()
+	return sources, applyLocale(cfg.Locale)
+}
 
-	// アプリケーション開始ログ
-	log.Infof("%s", i18n.Tf(i18n.MsgAppStarted, i18n.Tf(i18n.MsgAppVersion, config.Version)))
+// applyConfigFile コマンドラインで指定されなかったフラグに環境変数と設定ファイルの値を適用
+//
+// 優先度はフラグ > 環境変数 > プロファイル > 設定ファイルのデフォルト値 > フラグのデフォルト値。
+// 取得対象のゲーム (-appid, -game, -games) はひとまとまりとして扱い、
+// いずれかが優先度の高い取得元で指定された場合は低い取得元の値をすべて無視する。
+func applyConfigFile(fs *flag.FlagSet, cfg *config.Config) (map[string]string, error) {
+	sources := make(map[string]string)
+	explicit := make(map[string]bool)
+	targetExplicit := false
+	fs.VisitAll(func(f *flag.Flag) { sources[f.Name] = config.SourceDefault })
+	fs.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = true
+		sources[f.Name] = config.SourceFlag
+		if config.IsTargetKey(f.Name) {
+			targetExplicit = true
+		}
+	})
 
-	// 言語設定をパース
-	cfg.Languages = ParseLanguages(languageStr)
+	// 設定ファイルとプロファイルの指定は、設定ファイルを読み込む前に環境変数から適用する
+	env := config.EnvSettings(os.LookupEnv)
+	for _, key := range []string{"config", "profile"} {
+		if value, ok := env[key]; ok && !explicit[key] {
+			fs.Set(key, value)
+			sources[key] = config.SourceEnv
+		}
+	}
 
-	// バリデーション
-	if cfg.AppID == "" && cfg.GameName == "" {
-		fmt.Printf("%s\n\n", i18n.T(i18n.MsgErrorNoInput))
-		printUsage()
-		os.Exit(1)
+	path, err := config.FindFile(cfg.ConfigFile)
+	if err != nil {
+		return nil, newUsageError(i18n.Tf(i18n.MsgErrorConfigFind, err))
 	}
+	var file *config.File
+	if path != "" {
+		if file, err = config.LoadFile(path); err != nil {
+			return nil, usageError{err: err}
+		}
+	}
+	cfg.ConfigFile = path
 
-	if cfg.AppID != "" && cfg.GameName != "" {
-		fmt.Printf("%s\n\n", i18n.T(i18n.MsgErrorBothInputs))
-		printUsage()
-		os.Exit(1)
+	settings, settingSources, err := file.Settings(cfg.Profile)
+	if err != nil {
+		return nil, usageError{err: err}
 	}
+	config.MergeSettings(settings, settingSources, env, config.SourceEnv)
 
-	// 出力ディレクトリの作成
-	if cfg.OutputDir != "" {
-		if err := os.MkdirAll(cfg.OutputDir, 0755); err != nil {
-			log.Fatalf("%s", i18n.Tf(i18n.MsgErrorDirCreation, err))
+	for _, key := range config.FileKeys {
+		value, ok := settings[key]
+		if !ok || explicit[key] || (targetExplicit && config.IsTargetKey(key)) || fs.Lookup(key) == nil {
+			continue
 		}
+		if err := fs.Set(key, value); err != nil {
+			if settingSources[key] == config.SourceEnv {
+				return nil, newUsageError(i18n.Tf(i18n.MsgErrorEnvApply, value, config.EnvName(key), err))
+			}
+			return nil, newUsageError(i18n.Tf(i18n.MsgErrorConfigApply, value, key, path, err))
+		}
+		sources[key] = settingSources[key]
 	}
+	return sources, nil
+}
 
-	var reviews []models.ReviewData
-	var appID string
-	var gameName string
-	var gameDetails *models.GameDetails
-
-	// レビュー取得
-	if cfg.AppID != "" {
-		appID = cfg.AppID
-		gameName = fmt.Sprintf("App ID %s", appID)
-		log.Verbosef("App ID %s のレビューを取得中...", appID)
-		reviews, err = api.FetchAllReviews(appID, cfg.MaxReviews, cfg.Verbose, cfg.Languages, cfg.Filter, log)
-	} else {
-		gameName = cfg.GameName
-		log.Verbosef("ゲーム '%s' のレビューを取得中...", gameName)
-		reviews, appID, err = api.GetReviewsByGameName(gameName, cfg.MaxReviews, cfg.Verbose, cfg.Languages, cfg.Filter, log)
+// newLogger ロガーを作成（-verbose は -log-level debug と同じ）
+func newLogger(cfg config.Config) (*logger.Logger, error) {
+	level, ok := logger.ParseLevel(cfg.LogLevel)
+	if !ok {
+		return nil, newUsageError(i18n.Tf(i18n.MsgErrorLogLevel, cfg.LogLevel))
+	}
+	if cfg.Verbose {
+		level = min(level, slog.LevelDebug)
+	}
+	if cfg.LogFormat != config.LogFormatText && cfg.LogFormat != config.LogFormatJSON {
+		return nil, newUsageError(i18n.Tf(i18n.MsgErrorLogFormat, cfg.LogFormat))
 	}
 
+	log, err := logger.New("logs", logger.Options{Level: level, JSON: cfg.LogFormat == config.LogFormatJSON})
 	if err != nil {
-		log.Fatalf("%s", i18n.Tf(i18n.MsgErrorReviewFetch, err))
+		return nil, newFailure(exitWrite, errors.New(i18n.Tf(i18n.MsgErrorLoggerInit, err)))
 	}
+	log.SetQuiet(cfg.Quiet)
+	return log, nil
+}
 
-	if len(reviews) == 0 {
-		log.Info(i18n.T(i18n.MsgStatsNoReviews))
-		return
+// printUsage 使用方法を表示
+func printUsage() {
+	fmt.Printf(i18n.T(i18n.MsgUsageFull), config.AppName, config.Version)
+	fmt.Println()
+	fmt.Println(i18n.T(i18n.MsgUsageCommands))
+	for _, cmd := range commands() {
+		fmt.Printf("  %-9s %s\n", cmd.name, i18n.T(cmd.summary))
 	}
+	fmt.Println()
+	fmt.Println(i18n.T(i18n.MsgUsageGlobalOptions))
+	fmt.Println()
+	printEnvUsage(nil)
+}
 
-	log.Infof("取得したレビュー数: %d件", len(reviews))
+// printEnvUsage 設定を上書きできる環境変数の一覧を表示（fs が nil でなければそのフラグに対応するもののみ）
+func printEnvUsage(fs *flag.FlagSet) {
+	fmt.Println(i18n.T(i18n.MsgUsageEnvironment))
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, key := range config.EnvKeys {
+		if fs != nil && fs.Lookup(key) == nil {
+			continue
+		}
+		fmt.Fprintf(tw, "  %s\t-%s\n", config.EnvName(key), key)
+	}
+	tw.Flush()
+}
 
-	// ゲーム詳細情報を取得
-	gameDetails, err = api.GetGameDetails(appID, cfg.Verbose, log)
-	if err != nil {
-		log.Verbosef("%s", i18n.Tf(i18n.MsgErrorGameDetailsInit, err))
-		// ゲーム詳細情報が取得できなくてもレビュー保存は続行
-		gameDetails = nil
+// printCommandUsage サブコマンドの使用方法を表示（fs が nil でなければ対応する環境変数のみを表示）
+func printCommandUsage(name string, fs *flag.FlagSet) {
+	cmd, ok := findCommand(name)
+	if !ok {
+		printUsage()
+		return
 	}
+	fmt.Printf(i18n.T(cmd.usage), config.AppName, config.Version)
+	fmt.Println()
+	if name != "version" && name != "help" {
+		fmt.Println()
+		fmt.Println(i18n.T(i18n.MsgUsageGlobalOptions))
+		fmt.Println()
+		printEnvUsage(fs)
+	}
+}
 
-	// ファイル保存
-	ext := ".txt"
-	if cfg.OutputJSON {
-		ext = ".json"
+// runVersion version コマンド: バージョン情報を表示
+func runVersion(_ context.Context, args []string) error {
+	var cfg config.Config
+	fs := newFlagSet("version", &cfg)
+	if err := parseFlags(fs, args, &cfg); err != nil {
+		return err
 	}
-	baseFilename := fmt.Sprintf("steam_reviews_%s%s", appID, ext)
+	fmt.Printf("%s\n", i18n.Tf(i18n.MsgAppVersion, config.Version))
+	return nil
+}
 
-	var savedFiles []string
-	if cfg.SplitByLang {
-		files, err := storage.SaveReviewsByLanguageWithGameDetails(reviews, baseFilename, cfg.OutputDir, cfg.Verbose, cfg.OutputJSON, gameDetails)
-		if err != nil {
-			log.Errorf("%s", i18n.Tf(i18n.MsgErrorFileSave, err))
-		}
-		savedFiles = files
-	} else {
-		filename := baseFilename
-		if cfg.OutputDir != "" {
-			filename = cfg.OutputDir + "/" + filename
-		}
+// runHelp help コマンド: 全体またはサブコマンドの使用方法を表示
+func runHelp(_ context.Context, args []string) error {
+	var cfg config.Config
+	fs := newFlagSet("help", &cfg)
+	if err := parseFlags(fs, args, &cfg); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		printUsage()
+		return nil
+	}
+	if _, ok := findCommand(fs.Arg(0)); !ok {
+		return newUsageError(i18n.Tf(i18n.MsgErrorUnknownCommand, fs.Arg(0)))
+	}
+	printCommandUsage(fs.Arg(0), nil)
+	return nil
+}
+
+// run 引数に応じてサブコマンドを実行し、終了コードを返す
+//
+// 最初の引数がサブコマンド名でなくフラグの場合は、従来どおり fetch のフラグとして扱う。
+func run(args []string) int {
+	i18n.Init()
+
+	if len(args) == 0 {
+		printUsage()
+		return exitUsage
+	}
 
-		if savedFile, err := storage.SaveReviewsToFileWithGameDetails(reviews, filename, cfg.OutputJSON, gameDetails); err != nil {
-			log.Errorf("%s", i18n.Tf(i18n.MsgErrorFileSave, err))
+	name := args[0]
+	switch name {
+	case "-help", "--help", "-h":
+		printUsage()
+		return exitOK
+	case "-version", "--version":
+		name = "version"
+		args = args[1:]
+	default:
+		if strings.HasPrefix(name, "-") {
+			name = "fetch"
 		} else {
-			savedFiles = append(savedFiles, savedFile)
-			log.Verbosef("%s", i18n.Tf(i18n.MsgVerboseReviewSaved, filename))
+			args = args[1:]
 		}
 	}
 
-	// 保存したファイル一覧を表示（標準出力のみ）
-	log.Printf("\n%s", i18n.T(i18n.MsgFileSavedFiles))
-	for _, file := range savedFiles {
-		log.Printf("- %s\n", file)
+	cmd, ok := findCommand(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "%s\n\n", i18n.Tf(i18n.MsgErrorUnknownCommand, name))
+		printUsage()
+		return exitUsage
 	}
-	log.Println()
 
-	// ゲーム情報を使用して統計情報を表示
-	displayGameName := gameName
-	if gameDetails != nil {
-		displayGameName = gameDetails.Name
+	// SIGINT・SIGTERM で ctx をキャンセルし、各コマンドに後始末をさせる（2回目のシグナルでは即座に終了する）
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	context.AfterFunc(ctx, stop)
+
+	err := cmd.run(ctx, args)
+	var ie interruptedError
+	if err != nil && ctx.Err() != nil && !errors.As(err, &ie) {
+		err = interruptedError{err: err}
 	}
+	return exitCode(cmd.name, err)
+}
 
-	// 統計情報を表示
-	stats.PrintReviewStats(reviews, displayGameName, log)
+// errorExitCode コマンドの実行結果に対応する終了コード
+func errorExitCode(err error) int {
+	var ue usageError
+	var ie interruptedError
+	var fe failureError
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.As(err, &ie):
+		return exitInterrupted
+	case errors.As(err, &ue):
+		return exitUsage
+	case errors.As(err, &fe):
+		return fe.code
+	default:
+		return exitError
+	}
+}
 
-	log.Info(i18n.T(i18n.MsgSuccessCompleted))
+// exitCode コマンドの実行結果からエラーを表示し、終了コードを返す
+func exitCode(name string, err error) int {
+	code := errorExitCode(err)
+	var le loggedError
+	if code == exitOK || errors.As(err, &le) {
+		return code
+	}
+	fmt.Fprintf(os.Stderr, "%s\n", err)
+	if code == exitUsage {
+		fmt.Fprintf(os.Stderr, "%s\n", i18n.Tf(i18n.MsgUsageHint, name))
+	}
+	return code
+}
+
+func main() {
+	os.Exit(run(os.Args[1:]))
 }
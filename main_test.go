@@ -1,15 +1,29 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/y-moriya/steam-review/internal/api"
+	"github.com/y-moriya/steam-review/internal/logger"
 	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/internal/stats"
 	"github.com/y-moriya/steam-review/internal/storage"
 	"github.com/y-moriya/steam-review/pkg/config"
 	"github.com/y-moriya/steam-review/pkg/i18n"
@@ -129,6 +143,695 @@ func TestParseLanguages(t *testing.T) {
 	}
 }
 
+func TestRunExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{name: "No arguments", args: nil, want: exitUsage},
+		{name: "Help flag", args: []string{"-help"}, want: exitOK},
+		{name: "Version command", args: []string{"version"}, want: exitOK},
+		{name: "Legacy version flag", args: []string{"-version"}, want: exitOK},
+		{name: "Help for command", args: []string{"help", "stats"}, want: exitOK},
+		{name: "Help for unknown command", args: []string{"help", "bogus"}, want: exitUsage},
+		{name: "Unknown command", args: []string{"bogus"}, want: exitUsage},
+		{name: "Unknown flag", args: []string{"search", "-bogus"}, want: exitUsage},
+		{name: "Missing search query", args: []string{"search"}, want: exitUsage},
+		{name: "Missing stats files", args: []string{"stats"}, want: exitUsage},
+		{name: "Unknown locale", args: []string{"version", "-locale", "xx"}, want: exitUsage},
+		{name: "Missing watch App IDs", args: []string{"watch"}, want: exitUsage},
+		{name: "Short watch interval", args: []string{"watch", "-appids", "440", "-interval", "10s"}, want: exitUsage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(tt.args); got != tt.want {
+				t.Errorf("run(%q) = %d, want %d", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLexiconDirCheckedBeforeFetch(t *testing.T) {
+	badDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(badDir, "english.tsv"), []byte("good 2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	missingDir := filepath.Join(t.TempDir(), "missing")
+
+	// 辞書の問題はレビューの取得前に検出するため、通信せずに終了する
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{name: "Missing lexicon dir", args: []string{"fetch", "-appid", "440", "-sentiment", "-lexicon-dir", missingDir}, want: exitUsage},
+		{name: "Empty lexicon dir", args: []string{"fetch", "-appid", "440", "-sentiment", "-lexicon-dir", t.TempDir()}, want: exitUsage},
+		{name: "Malformed lexicon", args: []string{"fetch", "-appid", "440", "-sentiment", "-lexicon-dir", badDir}, want: exitError},
+		{name: "Stats with missing lexicon dir", args: []string{"stats", "-sentiment", "-lexicon-dir", missingDir, "reviews.json"}, want: exitUsage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := run(tt.args); got != tt.want {
+				t.Errorf("run(%q) = %d, want %d", tt.args, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExitCodeLoggedError(t *testing.T) {
+	err := loggedError{err: fmt.Errorf("failed")}
+	if got := exitCode("fetch", err); got != exitError {
+		t.Errorf("exitCode(loggedError) = %d, want %d", got, exitError)
+	}
+	if got := exitCode("fetch", fmt.Errorf("wrapped: %w", newUsageError("bad"))); got != exitUsage {
+		t.Errorf("exitCode(wrapped usageError) = %d, want %d", got, exitUsage)
+	}
+}
+
+func TestExitCodeInterrupted(t *testing.T) {
+	err := interruptedError{err: fmt.Errorf("interrupted")}
+	if got := exitCode("fetch", err); got != exitInterrupted {
+		t.Errorf("exitCode(interruptedError) = %d, want %d", got, exitInterrupted)
+	}
+	if got := exitCode("fetch", loggedError{err: err}); got != exitInterrupted {
+		t.Errorf("exitCode(logged interruptedError) = %d, want %d", got, exitInterrupted)
+	}
+}
+
+func TestExitCodeFailure(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"not found", newFailure(exitNotFound, errors.New("not found")), exitNotFound},
+		{"logged network", loggedError{err: newFailure(exitNetwork, errors.New("timeout"))}, exitNetwork},
+		{"wrapped write", wrapFailure(newFailure(exitWrite, errors.New("disk full")), "save: disk full"), exitWrite},
+		{"wrapped plain", wrapFailure(errors.New("bad"), "wrapped: bad"), exitError},
+		{"api not found", apiFailure(fmt.Errorf("lookup: %w", api.ErrGameNotFound)), exitNotFound},
+		{"api network", apiFailure(errors.New("connection refused")), exitNetwork},
+	}
+	for _, tt := range tests {
+		if got := errorExitCode(tt.err); got != tt.want {
+			t.Errorf("errorExitCode(%s) = %d, want %d", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestFetchGamesError(t *testing.T) {
+	tests := []struct {
+		codes []int
+		total int
+		want  int
+	}{
+		{[]int{exitNetwork}, 3, exitPartial},
+		{[]int{exitNotFound, exitNotFound}, 2, exitNotFound},
+		{[]int{exitNotFound, exitNetwork}, 2, exitError},
+	}
+	for _, tt := range tests {
+		if got := errorExitCode(fetchGamesError(tt.codes, tt.total)); got != tt.want {
+			t.Errorf("fetchGamesError(%v, %d) exit code = %d, want %d", tt.codes, tt.total, got, tt.want)
+		}
+	}
+}
+
+func TestRunSummary(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	summary := newRunSummary("fetch", start)
+	summary.add(gameResult{Target: "440", AppID: "440", Reviews: 100, Files: []string{"output/steam_reviews_440.json"}}, nil)
+	summary.add(gameResult{Target: "Unknown Game"}, newFailure(exitNotFound, errors.New("Game 'Unknown Game' not found")))
+	err := fetchGamesError([]int{exitNotFound}, 2)
+
+	filename := filepath.Join(t.TempDir(), "summary.json")
+	if got := summary.write(filename, err); got != err {
+		t.Fatalf("write() = %v, want %v", got, err)
+	}
+	data, readErr := os.ReadFile(filename)
+	if readErr != nil {
+		t.Fatal(readErr)
+	}
+	var got runSummary
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != summaryStatusPartial || got.ExitCode != exitPartial {
+		t.Errorf("status = %q (%d), want %q (%d)", got.Status, got.ExitCode, summaryStatusPartial, exitPartial)
+	}
+	if got.Games != (summaryCounts{Total: 2, Succeeded: 1, Failed: 1}) || got.Reviews != 100 {
+		t.Errorf("games = %+v, reviews = %d, want 2 games (1 failed) and 100 reviews", got.Games, got.Reviews)
+	}
+	if len(got.Files) != 1 || len(got.Errors) != 1 || got.Results[1].ExitCode != exitNotFound {
+		t.Errorf("files = %v, errors = %v, results = %+v", got.Files, got.Errors, got.Results)
+	}
+
+	// 取得を始める前のエラーも記録する
+	summary = newRunSummary("fetch", start)
+	summary.finish(newUsageError("no input"), start.Add(time.Second))
+	if summary.Status != summaryStatusFailed || summary.ExitCode != exitUsage || len(summary.Errors) != 1 || summary.DurationSeconds != 1 {
+		t.Errorf("summary = %+v, want failed with exit code %d and 1 error", summary, exitUsage)
+	}
+}
+
+func TestSavePartialReviews(t *testing.T) {
+	cfg := config.Config{OutputDir: t.TempDir(), OutputJSON: true}
+	reviews := []models.ReviewData{{RecommendationID: "1"}, {RecommendationID: "2"}}
+
+	var result gameResult
+	err := savePartialReviews(reviews, "440", cfg, nil, &result)
+	var ie interruptedError
+	if !errors.As(err, &ie) {
+		t.Fatalf("savePartialReviews() error = %v, want interruptedError", err)
+	}
+	if !result.Incomplete || len(result.Files) != 1 {
+		t.Errorf("result = %+v, want incomplete with 1 file", result)
+	}
+
+	data, err := os.ReadFile(filepath.Join(cfg.OutputDir, "steam_reviews_440.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved struct {
+		Metadata *models.FetchMetadata `json:"metadata"`
+		Reviews  []models.ReviewData   `json:"reviews"`
+	}
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved.Metadata == nil || !saved.Metadata.Incomplete || saved.Metadata.Reason != models.IncompleteInterrupted || len(saved.Reviews) != 2 {
+		t.Errorf("saved metadata = %+v with %d reviews, want incomplete (interrupted) with 2 reviews", saved.Metadata, len(saved.Reviews))
+	}
+}
+
+func TestSavePartialReviewsNetworkError(t *testing.T) {
+	cfg := config.Config{OutputDir: t.TempDir(), OutputJSON: true}
+	reviews := []models.ReviewData{{RecommendationID: "1"}}
+
+	var result gameResult
+	cause := newFailure(exitNetwork, errors.New("connection reset"))
+	err := savePartialReviews(reviews, "440", cfg, cause, &result)
+	if code := errorExitCode(err); code != exitNetwork {
+		t.Errorf("savePartialReviews() exit code = %d, want %d (%v)", code, exitNetwork, err)
+	}
+	if !result.Incomplete || len(result.Files) != 1 {
+		t.Errorf("result = %+v, want incomplete with 1 file", result)
+	}
+
+	data, err := os.ReadFile(result.Files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"reason": "network_error"`) {
+		t.Errorf("saved file does not record the network error reason: %s", data)
+	}
+}
+
+func TestEstimateFetch(t *testing.T) {
+	tests := []struct {
+		total, max   int
+		wantReviews  int
+		wantPages    int
+		wantDuration time.Duration
+	}{
+		{total: 5000, max: 250, wantReviews: 250, wantPages: 3, wantDuration: 3*500*time.Millisecond + 2*time.Second},
+		{total: 80, max: 0, wantReviews: 80, wantPages: 1, wantDuration: 500 * time.Millisecond},
+		{total: 0, max: 100, wantReviews: 0, wantPages: 1, wantDuration: 500 * time.Millisecond},
+		{total: 1000, max: 0, wantReviews: 1000, wantPages: 10, wantDuration: 10*500*time.Millisecond + 9*time.Second},
+	}
+	for _, tt := range tests {
+		reviews, pages, duration := estimateFetch(tt.total, tt.max, 500*time.Millisecond)
+		if reviews != tt.wantReviews || pages != tt.wantPages || duration != tt.wantDuration {
+			t.Errorf("estimateFetch(%d, %d) = %d, %d, %v, want %d, %d, %v",
+				tt.total, tt.max, reviews, pages, duration, tt.wantReviews, tt.wantPages, tt.wantDuration)
+		}
+	}
+}
+
+func TestPlannedFiles(t *testing.T) {
+	cfg := config.Config{OutputDir: "out", ExportUnanswered: true, Keywords: true, StatsFile: "stats.json"}
+	want := []string{"out/steam_reviews_440.txt", "out/steam_reviews_440_unanswered_negative.txt", "out/steam_reviews_440_keywords.csv", "stats.json"}
+	if got := plannedFiles("440", cfg); !reflect.DeepEqual(got, want) {
+		t.Errorf("plannedFiles() = %v, want %v", got, want)
+	}
+
+	cfg = config.Config{OutputDir: "out", OutputJSON: true, SplitByLang: true, Languages: []string{"japanese", "english"}}
+	want = []string{"out/steam_reviews_440_japanese.json", "out/steam_reviews_440_english.json", "out/steam_reviews_440_all_languages.json"}
+	if got := plannedFiles("440", cfg); !reflect.DeepEqual(got, want) {
+		t.Errorf("plannedFiles() with -split = %v, want %v", got, want)
+	}
+
+	cfg.Languages = []string{config.LanguageAll}
+	want = []string{"out/steam_reviews_440_<language>.json", "out/steam_reviews_440_all_languages.json"}
+	if got := plannedFiles("440", cfg); !reflect.DeepEqual(got, want) {
+		t.Errorf("plannedFiles() with -split -lang all = %v, want %v", got, want)
+	}
+}
+
+func TestStatsFilename(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  config.Config
+		want string
+	}{
+		{"No stats file", config.Config{Games: []string{"440", "730"}}, ""},
+		{"Single game", config.Config{AppID: "440", StatsFile: "out/stats.json"}, "out/stats.json"},
+		{"One game in the list", config.Config{Games: []string{"440"}, StatsFile: "stats.csv"}, "stats.csv"},
+		{"Several games", config.Config{Games: []string{"440", "Dota 2"}, StatsFile: "out/stats.json"}, "out/stats_440.json"},
+		{"No extension", config.Config{Games: []string{"440", "730"}, StatsFile: "stats"}, "stats_440"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statsFilename("440", tt.cfg); got != tt.want {
+				t.Errorf("statsFilename() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompletionCommands(t *testing.T) {
+	cmds := completionCommands()
+	if flagSetCollector != nil {
+		t.Fatal("flagSetCollector is not reset")
+	}
+	if len(cmds) != len(commands()) {
+		t.Fatalf("completionCommands() returned %d commands, want %d", len(cmds), len(commands()))
+	}
+
+	var fetch completionCommand
+	for _, cmd := range cmds {
+		if cmd.name == "fetch" {
+			fetch = cmd
+		}
+	}
+	flags := make(map[string]completionFlag)
+	for _, f := range fetch.flags {
+		flags[f.name] = f
+	}
+	for _, name := range []string{"appid", "dry-run", "webhook", "verbose", "output"} {
+		if _, ok := flags[name]; !ok {
+			t.Errorf("fetch flags do not include -%s", name)
+		}
+	}
+	if !flags["dry-run"].isBool || flags["max"].isBool {
+		t.Errorf("isBool: -dry-run = %v, -max = %v, want true, false", flags["dry-run"].isBool, flags["max"].isBool)
+	}
+	if !reflect.DeepEqual(flags["filter"].values, []string{"all", "recent", "updated"}) {
+		t.Errorf("-filter values = %v", flags["filter"].values)
+	}
+	if flags["game"].kind != completeGames || flags["output"].kind != completeDirs {
+		t.Errorf("kinds: -game = %v, -output = %v", flags["game"].kind, flags["output"].kind)
+	}
+}
+
+func TestCompletionScripts(t *testing.T) {
+	cmds := completionCommands()
+	scripts := map[string]string{
+		"bash": bashCompletion(cmds, "/tmp/it's/apps.txt"),
+		"zsh":  zshCompletion(cmds, "/tmp/it's/apps.txt"),
+		"fish": fishCompletion(cmds, "/tmp/it's/apps.txt"),
+	}
+	for shell, script := range scripts {
+		for _, want := range []string{"dry-run", "schinese", "discord", "completion"} {
+			if !strings.Contains(script, want) {
+				t.Errorf("%s script does not contain %q", shell, want)
+			}
+		}
+
+		// シェルがインストールされていれば構文を確認する
+		path, err := exec.LookPath(shell)
+		if err != nil {
+			continue
+		}
+		file := filepath.Join(t.TempDir(), "completion."+shell)
+		if err := os.WriteFile(file, []byte(script), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if out, err := exec.Command(path, "-n", file).CombinedOutput(); err != nil {
+			t.Errorf("%s -n: %v\n%s", shell, err, out)
+		}
+	}
+}
+
+func TestReadGameList(t *testing.T) {
+	games, err := readGameList(strings.NewReader("440\n\n# comment\n  Elden Ring  \n730"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"440", "Elden Ring", "730"}
+	if !reflect.DeepEqual(games, want) {
+		t.Errorf("readGameList() = %v, want %v", games, want)
+	}
+}
+
+func TestLoadReviewFilesStdin(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "reviews.json")
+	saved := []models.ReviewData{{RecommendationID: "1", Review: "saved"}, {RecommendationID: "2", Review: "saved"}}
+	if _, err := storage.SaveReviewsToFileWithGameDetails(saved, file, true, &models.GameDetails{Name: "Test Game"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"jsonl", `{"recommendation_id":"2","review":"stdin"}` + "\n" + `{"recommendation_id":"3","review":"stdin"}` + "\n"},
+		{"array", `[{"recommendation_id":"2","review":"stdin"},{"recommendation_id":"3","review":"stdin"}]`},
+		{"object", `{"reviews":[{"recommendation_id":"2","review":"stdin"},{"recommendation_id":"3","review":"stdin"}]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviews, gameDetails, err := loadReviewFiles([]string{file, stdinArg}, strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []string
+			for _, review := range reviews {
+				ids = append(ids, review.RecommendationID)
+			}
+			if !reflect.DeepEqual(ids, []string{"1", "2", "3"}) {
+				t.Errorf("review IDs = %v, want [1 2 3]", ids)
+			}
+			if gameDetails == nil || gameDetails.Name != "Test Game" {
+				t.Errorf("gameDetails = %+v, want Test Game", gameDetails)
+			}
+		})
+	}
+
+	if _, _, err := loadReviewFiles([]string{stdinArg}, strings.NewReader("{not json")); err == nil {
+		t.Error("loadReviewFiles() with invalid input returned no error")
+	}
+}
+
+func TestWriteExportJSONL(t *testing.T) {
+	var buf strings.Builder
+	reviews := []models.ReviewData{{RecommendationID: "1"}, {RecommendationID: "2"}}
+	if err := writeExport(&buf, config.ExportFormatJSONL, reviews, nil); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[1], `"recommendation_id":"2"`) {
+		t.Errorf("jsonl output = %q", buf.String())
+	}
+}
+
+// failingWriter 常に書き込みに失敗する io.Writer（ディスクフルなどの再現用）
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("no space left on device")
+}
+
+func TestWriteErrors(t *testing.T) {
+	reviews := []models.ReviewData{{RecommendationID: "1", Review: "Great"}}
+	for _, format := range []string{config.ExportFormatText, config.ExportFormatJSON, config.ExportFormatCSV, config.ExportFormatJSONL} {
+		if err := writeExport(failingWriter{}, format, reviews, nil); err == nil {
+			t.Errorf("writeExport(%s) to a failing writer returned no error", format)
+		}
+	}
+	rs := stats.Compute(reviews, "Test Game", stats.DefaultOptions())
+	if err := stats.Write(failingWriter{}, rs, config.StatsFormatText); err == nil {
+		t.Error("stats.Write(text) to a failing writer returned no error")
+	}
+}
+
+func TestConfigShowKeys(t *testing.T) {
+	var cfg config.Config
+	fs := newConfigShowFlagSet(&cfg)
+	for _, key := range config.FileKeys {
+		if fs.Lookup(key) == nil {
+			t.Errorf("config show does not register %q", key)
+		}
+	}
+}
+
+func TestApplyConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	content := `max = 50
+lang = ["japanese", "english"]
+appid = "440"
+
+[profiles.daily]
+max = 200
+filter = "recent"
+games = ["730", "Elden Ring"]
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	parse := func(args ...string) (config.Config, fetchFlags, map[string]string) {
+		t.Helper()
+		var cfg config.Config
+		var ff fetchFlags
+		fs := newFlagSet("fetch", &cfg)
+		registerFetchFlags(fs, &cfg, &ff)
+		sources, err := parseFlagsWithSources(fs, append([]string{"-config", path}, args...), &cfg)
+		if err != nil {
+			t.Fatalf("parseFlagsWithSources(%q) error = %v", args, err)
+		}
+		return cfg, ff, sources
+	}
+
+	cfg, ff, sources := parse()
+	if cfg.MaxReviews != 50 || ff.languages != "japanese,english" || cfg.AppID != "440" || sources["max"] != config.SourceFile {
+		t.Errorf("file defaults: max=%d lang=%q appid=%q source=%s", cfg.MaxReviews, ff.languages, cfg.AppID, sources["max"])
+	}
+
+	cfg, ff, sources = parse("-profile", "daily", "-max", "5")
+	if cfg.MaxReviews != 5 || sources["max"] != config.SourceFlag {
+		t.Errorf("flag should override profile: max=%d source=%s", cfg.MaxReviews, sources["max"])
+	}
+	if cfg.Filter != config.FilterRecent || sources["filter"] != config.SourceProfile {
+		t.Errorf("profile should override default: filter=%q source=%s", cfg.Filter, sources["filter"])
+	}
+	if cfg.AppID != "" || ff.games != "730,Elden Ring" {
+		t.Errorf("profile games should replace file appid: appid=%q games=%q", cfg.AppID, ff.games)
+	}
+
+	cfg, ff, _ = parse("-profile", "daily", "-game", "Portal 2")
+	if cfg.GameName != "Portal 2" || cfg.AppID != "" || ff.games != "" {
+		t.Errorf("-game should ignore targets from the config file: appid=%q games=%q", cfg.AppID, ff.games)
+	}
+}
+
+func TestApplyEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+	content := `max = 50
+
+[profiles.daily]
+filter = "recent"
+games = ["730"]
+`
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("STEAM_REVIEW_CONFIG", path)
+	t.Setenv("STEAM_REVIEW_PROFILE", "daily")
+	t.Setenv("STEAM_REVIEW_MAX", "300")
+	t.Setenv("STEAM_REVIEW_FILTER", "updated")
+	t.Setenv("STEAM_REVIEW_APPID", "440")
+
+	var cfg config.Config
+	var ff fetchFlags
+	fs := newFlagSet("fetch", &cfg)
+	registerFetchFlags(fs, &cfg, &ff)
+	sources, err := parseFlagsWithSources(fs, []string{"-filter", "recent"}, &cfg)
+	if err != nil {
+		t.Fatalf("parseFlagsWithSources() error = %v", err)
+	}
+	if cfg.ConfigFile != path || cfg.Profile != "daily" {
+		t.Errorf("config=%q profile=%q, want values from the environment", cfg.ConfigFile, cfg.Profile)
+	}
+	if cfg.MaxReviews != 300 || sources["max"] != config.SourceEnv {
+		t.Errorf("environment should override the config file: max=%d source=%s", cfg.MaxReviews, sources["max"])
+	}
+	if cfg.Filter != config.FilterRecent || sources["filter"] != config.SourceFlag {
+		t.Errorf("flag should override the environment: filter=%q source=%s", cfg.Filter, sources["filter"])
+	}
+	if cfg.AppID != "440" || ff.games != "" {
+		t.Errorf("environment appid should replace profile games: appid=%q games=%q", cfg.AppID, ff.games)
+	}
+
+	t.Setenv("STEAM_REVIEW_MAX", "many")
+	var cfg2 config.Config
+	fs = newFlagSet("fetch", &cfg2)
+	registerFetchFlags(fs, &cfg2, &ff)
+	if _, err := parseFlagsWithSources(fs, nil, &cfg2); err == nil {
+		t.Error("invalid STEAM_REVIEW_MAX should be an error")
+	}
+}
+
+func TestWatchGame(t *testing.T) {
+	dir := t.TempDir()
+	g, err := loadWatchGame("440", dir)
+	if err != nil {
+		t.Fatalf("loadWatchGame() error = %v", err)
+	}
+	if len(g.reviews) != 0 || g.saved || g.filename != filepath.Join(dir, "steam_reviews_440.json") {
+		t.Fatalf("new game: reviews=%d saved=%t filename=%q", len(g.reviews), g.saved, g.filename)
+	}
+
+	added := g.merge([]models.ReviewData{{RecommendationID: "2"}, {RecommendationID: "1"}})
+	if len(added) != 2 {
+		t.Fatalf("merge() added %d reviews, want 2", len(added))
+	}
+	if err := g.save(); err != nil {
+		t.Fatalf("save() error = %v", err)
+	}
+
+	// 保存したファイルを読み込み直し、既知のレビューを除いて新しいレビューを先頭に追加する
+	g, err = loadWatchGame("440", dir)
+	if err != nil {
+		t.Fatalf("loadWatchGame() error = %v", err)
+	}
+	if !g.saved {
+		t.Error("loadWatchGame() did not mark the saved file as loaded")
+	}
+	added = g.merge([]models.ReviewData{{RecommendationID: "4"}, {RecommendationID: "3"}, {RecommendationID: "3"}, {RecommendationID: "2"}})
+	if len(added) != 2 {
+		t.Errorf("merge() added %d reviews, want 2", len(added))
+	}
+	var ids []string
+	for _, review := range g.reviews {
+		ids = append(ids, review.RecommendationID)
+	}
+	if fmt.Sprint(ids) != "[4 3 2 1]" {
+		t.Errorf("reviews = %v, want [4 3 2 1]", ids)
+	}
+}
+
+func TestWatchPollBeyondMax(t *testing.T) {
+	// Steam には作成日時の新しい順に 6, 5, ..., 1 のレビューがある
+	var steamReviews []models.SteamReview
+	for id := 6; id >= 1; id-- {
+		steamReviews = append(steamReviews, models.SteamReview{RecommendationID: strconv.Itoa(id), Language: "english"})
+	}
+	stubSteam(t, func(string) []models.SteamReview { return steamReviews })
+	log := logger.NewWriter(io.Discard, io.Discard, io.Discard, logger.Options{})
+	cfg := config.Config{MaxReviews: 2, Languages: []string{"english"}}
+
+	// 保存済みのレビューがない場合は -max 件まで
+	g, err := loadWatchGame("440", t.TempDir())
+	if err != nil {
+		t.Fatalf("loadWatchGame() error = %v", err)
+	}
+	if err := g.poll(context.Background(), cfg, nil, log); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	if len(g.reviews) != 2 {
+		t.Fatalf("first poll saved %d reviews, want 2", len(g.reviews))
+	}
+
+	// 前回から -max より多くのレビューが増えても、既知のレビューまですべて取得する
+	g.reviews, g.known = nil, map[string]bool{"1": true}
+	if err := g.poll(context.Background(), cfg, nil, log); err != nil {
+		t.Fatalf("poll() error = %v", err)
+	}
+	var ids []string
+	for _, review := range g.reviews {
+		ids = append(ids, review.RecommendationID)
+	}
+	if fmt.Sprint(ids) != "[6 5 4 3 2]" {
+		t.Errorf("reviews after poll = %v, want [6 5 4 3 2]", ids)
+	}
+}
+
+// roundTripFunc 関数を http.RoundTripper として使う
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+// stubSteam Steam への通信を差し替え、App ID ごとに reviews が返すレビューを1ページで返す（ゲーム詳細は見つからない扱い）
+func stubSteam(t *testing.T, reviews func(appID string) []models.SteamReview) {
+	t.Helper()
+	orig := http.DefaultTransport
+	http.DefaultTransport = roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		body := []byte("{}")
+		if appID, ok := strings.CutPrefix(r.URL.Path, "/appreviews/"); ok {
+			var err error
+			body, err = json.Marshal(models.SteamReviewResponse{Success: 1, Reviews: reviews(appID), Cursor: api.FirstCursor})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(bytes.NewReader(body)), Request: r}, nil
+	})
+	t.Cleanup(func() { http.DefaultTransport = orig })
+}
+
+// captureStdout f を実行している間に標準出力に書き込まれた内容を返す
+func captureStdout(t *testing.T, f func()) string {
+	t.Helper()
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := os.Stdout
+	os.Stdout = w
+	done := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(r)
+		done <- data
+	}()
+	f()
+	os.Stdout = orig
+	w.Close()
+	return string(<-done)
+}
+
+func TestFetchStatsToStdout(t *testing.T) {
+	t.Chdir(t.TempDir())
+	stubSteam(t, func(appID string) []models.SteamReview {
+		return []models.SteamReview{
+			{RecommendationID: appID + "1", Language: "english", VotedUp: true},
+			{RecommendationID: appID + "2", Language: "english"},
+		}
+	})
+
+	// ログや保存したファイルの一覧は標準エラー出力に表示し、標準出力は複数のゲームでも1つのデータとして読み込める
+	for _, format := range []string{config.StatsFormatJSON, config.StatsFormatCSV} {
+		t.Run(format, func(t *testing.T) {
+			var code int
+			stdout := captureStdout(t, func() {
+				code = run([]string{"fetch", "-games", "440,570", "-lang", "english", "-stats-format", format})
+			})
+			if code != exitOK {
+				t.Fatalf("run() = %d, want %d", code, exitOK)
+			}
+
+			var games []string
+			if format == config.StatsFormatJSON {
+				for _, line := range strings.Split(strings.TrimSuffix(stdout, "\n"), "\n") {
+					var rs stats.ReviewStats
+					if err := json.Unmarshal([]byte(line), &rs); err != nil {
+						t.Fatalf("stdout line is not JSON: %v: %q", err, stdout)
+					}
+					games = append(games, rs.GameName)
+				}
+			} else {
+				rows, err := csv.NewReader(strings.NewReader(stdout)).ReadAll()
+				if err != nil {
+					t.Fatalf("stdout is not CSV: %v: %q", err, stdout)
+				}
+				for i, row := range rows {
+					if row[0] == "section" && i > 0 {
+						t.Errorf("CSV header repeated at row %d", i)
+					}
+					if row[0] == "summary" && row[2] == "game_name" {
+						games = append(games, row[3])
+					}
+				}
+			}
+			if fmt.Sprint(games) != "[App ID 440 App ID 570]" {
+				t.Errorf("games in stdout = %q, want App ID 440 and 570", games)
+			}
+		})
+	}
+}
+
 // テスト用にmain()をラップした関数
 func runMain() error {
 	// i18n システムを初期化
@@ -189,13 +892,13 @@ func runMain() error {
 		if cfg.Verbose {
 			log.Printf("App ID %s のレビューを取得中...", appID)
 		}
-		reviews, err = api.FetchAllReviews(appID, cfg.MaxReviews, cfg.Verbose, cfg.Languages, cfg.Filter, nil)
+		reviews, err = api.FetchAllReviews(appID, cfg.MaxReviews, cfg.Languages, cfg.Filter, nil)
 	} else {
 		gameName = cfg.GameName
 		if cfg.Verbose {
 			log.Printf("ゲーム '%s' のレビューを取得中...", gameName)
 		}
-		reviews, appID, err = api.GetReviewsByGameName(gameName, cfg.MaxReviews, cfg.Verbose, cfg.Languages, cfg.Filter, nil)
+		reviews, appID, err = api.GetReviewsByGameName(gameName, cfg.MaxReviews, cfg.Languages, cfg.Filter, nil)
 	}
 
 	if err != nil {
@@ -213,7 +916,7 @@ func runMain() error {
 	baseFilename := fmt.Sprintf("steam_reviews_%s%s", appID, ext)
 
 	if cfg.SplitByLang {
-		_, err := storage.SaveReviewsByLanguage(reviews, baseFilename, cfg.OutputDir, cfg.Verbose, cfg.OutputJSON)
+		_, err := storage.SaveReviewsByLanguage(reviews, baseFilename, cfg.OutputDir, nil, cfg.OutputJSON)
 		if err != nil {
 			return fmt.Errorf("%s", i18n.Tf(i18n.MsgErrorFileSave, err))
 		}
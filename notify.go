@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/y-moriya/steam-review/internal/logger"
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/internal/notify"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// notifyFlags 通知関連のフラグのうち、Config に直接格納しないもの
+type notifyFlags struct {
+	notifyOn string
+	keywords string
+}
+
+// registerNotifyFlags fetch と watch で共通の通知フラグを登録
+func registerNotifyFlags(fs *flag.FlagSet, cfg *config.Config, nf *notifyFlags) {
+	fs.StringVar(&cfg.Webhook, "webhook", "", "新しいレビューを通知するWebhook URL")
+	fs.StringVar(&cfg.WebhookFormat, "webhook-format", config.WebhookFormatJSON, "Webhookに送信する本文の形式 (json, slack, discord)")
+	fs.StringVar(&cfg.WebhookTemplate, "webhook-template", "", "本文のテンプレートファイル (Go の text/template)")
+	fs.IntVar(&cfg.WebhookBatch, "webhook-batch", notify.DefaultBatchSize, "1回の通知に含める最大レビュー数")
+	fs.IntVar(&cfg.WebhookRetries, "webhook-retries", notify.DefaultRetries, "通知の送信に失敗した場合の再試行回数")
+	fs.StringVar(&nf.notifyOn, "notify-on", config.NotifyNew, "通知する条件 (new, negative, keywords のカンマ区切り)")
+	fs.IntVar(&cfg.NotifyNegative, "notify-negative", 5, "新しい否定的レビューがこの件数以上で通知 (-notify-on negative)")
+	fs.StringVar(&nf.keywords, "notify-keywords", "", "本文に含まれていれば通知するキーワード (カンマ区切り, -notify-on keywords)")
+}
+
+// parseNotifyFlags カンマ区切りの通知フラグを Config に設定
+func parseNotifyFlags(cfg *config.Config, nf notifyFlags) {
+	cfg.NotifyOn = ParseLanguages(nf.notifyOn)
+	cfg.NotifyKeywords = ParseLanguages(nf.keywords)
+}
+
+// newNotifier 通知の設定から Notifier を作成（Webhook URL が指定されていない場合は nil）
+func newNotifier(cfg config.Config) (*notify.Notifier, error) {
+	if cfg.Webhook == "" {
+		return nil, nil
+	}
+
+	var tmpl string
+	if cfg.WebhookTemplate != "" {
+		data, err := os.ReadFile(cfg.WebhookTemplate)
+		if err != nil {
+			return nil, errors.New(i18n.Tf(i18n.MsgErrorWebhookTemplate, err))
+		}
+		tmpl = string(data)
+	}
+
+	n, err := notify.New(notify.Options{
+		URL:       cfg.Webhook,
+		Format:    cfg.WebhookFormat,
+		Template:  tmpl,
+		Events:    cfg.NotifyOn,
+		Negative:  cfg.NotifyNegative,
+		Keywords:  cfg.NotifyKeywords,
+		BatchSize: cfg.WebhookBatch,
+		Retries:   cfg.WebhookRetries,
+	})
+	if err != nil {
+		return nil, usageError{err: err}
+	}
+	return n, nil
+}
+
+// sendNotifications 新しいレビューのうち条件に該当するものを通知（失敗してもエラーを記録するのみ）
+func sendNotifications(ctx context.Context, n *notify.Notifier, appID string, gameDetails *models.GameDetails, reviews []models.ReviewData, log *logger.Logger) {
+	if n == nil || len(reviews) == 0 {
+		return
+	}
+	gameName := fmt.Sprintf("App ID %s", appID)
+	if gameDetails != nil {
+		gameName = gameDetails.Name
+	}
+
+	sent, err := n.NotifyContext(ctx, appID, gameName, reviews)
+	if err != nil {
+		log.Error(i18n.Tf(i18n.MsgErrorNotify, appID, err), "appid", appID)
+	}
+	if sent > 0 {
+		log.Debug(i18n.Tf(i18n.MsgNotifySent, appID, sent), "appid", appID)
+	}
+}
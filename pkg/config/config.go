@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 const (
 	// バージョン情報
 	Version = "v0.5.2"                 // プログラムのバージョン
@@ -11,19 +13,94 @@ const (
 	FilterUpdated = "updated" // 最終更新日時による並び替え
 
 	// ファイル形式
-	FileExtJSON = ".json" // JSON形式のファイル拡張子
-	FileExtTXT  = ".txt"  // テキスト形式のファイル拡張子
+	FileExtJSON  = ".json"  // JSON形式のファイル拡張子
+	FileExtTXT   = ".txt"   // テキスト形式のファイル拡張子
+	FileExtCSV   = ".csv"   // CSV形式のファイル拡張子
+	FileExtJSONL = ".jsonl" // JSON Lines形式のファイル拡張子
+
+	// 統計の出力形式
+	StatsFormatText = "text" // 人が読むためのテキスト形式
+	StatsFormatJSON = "json" // JSON形式
+	StatsFormatCSV  = "csv"  // section,group,metric,value のCSV形式
+
+	// export コマンドの出力形式
+	ExportFormatText  = "text"  // テキスト形式
+	ExportFormatJSON  = "json"  // JSON形式
+	ExportFormatCSV   = "csv"   // 1レビュー1行のCSV形式
+	ExportFormatJSONL = "jsonl" // 1レビュー1行のJSON Lines形式
+
+	// ログのレベル
+	LogLevelDebug = "debug" // 詳細ログ以上 (-verbose と同じ)
+	LogLevelInfo  = "info"  // 情報ログ以上
+	LogLevelWarn  = "warn"  // 警告ログ以上
+	LogLevelError = "error" // エラーログのみ
+
+	// ログの形式
+	LogFormatText = "text" // 端末には人が読む形式、ログファイルには key=value の形式
+	LogFormatJSON = "json" // 端末・ログファイルともに1行1レコードのJSON形式
+
+	// Webhookに送信する本文の形式
+	WebhookFormatJSON    = "json"    // 通知内容のJSON
+	WebhookFormatSlack   = "slack"   // Slackの Incoming Webhook 形式 ({"text": ...})
+	WebhookFormatDiscord = "discord" // DiscordのWebhook形式 ({"content": ...})
+
+	// Webhookで通知する条件
+	NotifyNew      = "new"      // 新しいレビューがある
+	NotifyNegative = "negative" // 新しい否定的レビューがしきい値以上ある
+	NotifyKeywords = "keywords" // 新しいレビューの本文がキーワードを含む
+
+	// watch コマンドの取得間隔
+	DefaultWatchInterval = 15 * time.Minute // デフォルトの取得間隔
+	MinWatchInterval     = time.Minute      // 取得間隔の下限
 )
 
 // Config コマンドライン引数の設定
 type Config struct {
 	AppID       string
 	GameName    string
+	Games       []string // 取得対象のゲームの一覧 (App ID またはゲーム名。AppID, GameName が空の場合に使用)
 	MaxReviews  int
 	Languages   []string
 	OutputDir   string
 	Verbose     bool
+	Quiet       bool   // エラー以外のログと進捗を端末に出力しない（統計などの結果は出力する）
+	LogLevel    string // 出力するログの最低レベル (debug, info, warn, error)
+	LogFormat   string // ログの形式 (text, json)
+	Locale      string // 表示言語 (en, ja。空の場合は環境変数から判定)
+	ConfigFile  string // 設定ファイルのパス (空の場合は検索)
+	Profile     string // 設定ファイルのプロファイル名
 	SplitByLang bool
 	OutputJSON  bool
 	Filter      string // レビューのフィルター
+
+	PlaytimeBuckets  []int // プレイ時間区分の境界（時間単位）
+	ExportUnanswered bool  // 未返信の否定的レビューを別ファイルに保存
+
+	SummaryFile string // 実行結果の要約 (JSON) の出力先ファイル (空の場合は出力しない)
+
+	Keywords      bool   // キーワード分析を行う
+	KeywordsTop   int    // キーワード分析で表示する上位語数
+	StopwordsFile string // 追加のストップワードファイル
+
+	Sentiment  bool   // 辞書ベースの感情スコアを計算する
+	LexiconDir string // 同梱の辞書を置き換える感情辞書のディレクトリ
+
+	TopHelpful int // 統計に表示する最も有用なレビューの件数
+
+	StatsFormat string // 統計の出力形式 (text, json, csv)
+	StatsFile   string // 統計の出力先ファイル (空の場合は標準出力)
+	Charts      bool   // 統計にチャートを表示する（標準出力が端末の場合のみ）
+	ChartStyle  string // チャートの文字セット (unicode, ascii)
+
+	Webhook         string   // 通知を送信するWebhook URL (空の場合は通知しない)
+	WebhookFormat   string   // Webhookに送信する本文の形式 (json, slack, discord)
+	WebhookTemplate string   // 本文のテンプレートファイル (text/template)
+	WebhookBatch    int      // 1回の通知に含める最大レビュー数
+	WebhookRetries  int      // 送信に失敗した場合の再試行回数
+	NotifyOn        []string // 通知する条件 (new, negative, keywords)
+	NotifyNegative  int      // 否定的レビューの通知のしきい値
+	NotifyKeywords  []string // 通知するキーワード
+
+	AppIDs        []string      // watch コマンドで監視するゲームのApp ID
+	WatchInterval time.Duration // watch コマンドの取得間隔
 }
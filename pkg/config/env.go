@@ -0,0 +1,37 @@
+package config
+
+import "strings"
+
+// EnvPrefix 設定を上書きする環境変数の接頭辞
+const EnvPrefix = "STEAM_REVIEW_"
+
+// envNames キー名から機械的に決まらない環境変数名
+var envNames = map[string]string{
+	"lang":   EnvPrefix + "LANGUAGES",  // STEAM_REVIEW_LANG は表示言語に使用済み
+	"output": EnvPrefix + "OUTPUT_DIR", // 出力ディレクトリ
+	"locale": EnvPrefix + "LANG",       // 表示言語（従来から使用している環境変数）
+}
+
+// EnvKeys 環境変数で指定できるキー（設定ファイルのキーに加え、設定ファイルの指定を含む）
+var EnvKeys = append([]string{"config", "profile"}, FileKeys...)
+
+// EnvName キーに対応する環境変数名を返す (例: "top-helpful" -> "STEAM_REVIEW_TOP_HELPFUL")
+func EnvName(key string) string {
+	if name, ok := envNames[key]; ok {
+		return name
+	}
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(key, "-", "_"))
+}
+
+// EnvSettings 環境変数から設定を読み込む
+//
+// lookup には通常 os.LookupEnv を渡す。空の値は指定されていないものとして扱う。
+func EnvSettings(lookup func(string) (string, bool)) map[string]string {
+	settings := make(map[string]string)
+	for _, key := range EnvKeys {
+		if value, ok := lookup(EnvName(key)); ok && strings.TrimSpace(value) != "" {
+			settings[key] = strings.TrimSpace(value)
+		}
+	}
+	return settings
+}
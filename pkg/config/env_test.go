@@ -0,0 +1,43 @@
+package config
+
+import "testing"
+
+func TestEnvName(t *testing.T) {
+	tests := map[string]string{
+		"appid":       "STEAM_REVIEW_APPID",
+		"top-helpful": "STEAM_REVIEW_TOP_HELPFUL",
+		"lang":        "STEAM_REVIEW_LANGUAGES",
+		"output":      "STEAM_REVIEW_OUTPUT_DIR",
+		"locale":      "STEAM_REVIEW_LANG",
+	}
+	for key, want := range tests {
+		if got := EnvName(key); got != want {
+			t.Errorf("EnvName(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestEnvSettings(t *testing.T) {
+	env := map[string]string{
+		"STEAM_REVIEW_MAX":       " 500 ",
+		"STEAM_REVIEW_LANGUAGES": "japanese,english",
+		"STEAM_REVIEW_PROFILE":   "daily",
+		"STEAM_REVIEW_GAME":      "",
+		"STEAM_REVIEW_UNKNOWN":   "x",
+	}
+	lookup := func(name string) (string, bool) {
+		value, ok := env[name]
+		return value, ok
+	}
+
+	settings := EnvSettings(lookup)
+	want := map[string]string{"max": "500", "lang": "japanese,english", "profile": "daily"}
+	if len(settings) != len(want) {
+		t.Errorf("EnvSettings() = %v, want %v", settings, want)
+	}
+	for key, value := range want {
+		if settings[key] != value {
+			t.Errorf("EnvSettings()[%q] = %q, want %q", key, settings[key], value)
+		}
+	}
+}
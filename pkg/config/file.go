@@ -0,0 +1,383 @@
+package config
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+const (
+	// 設定ファイル
+	LocalConfigFile = "steam-review.toml" // カレントディレクトリで検索する設定ファイル名
+	UserConfigDir   = "steam-review"      // ユーザー設定ディレクトリ内のディレクトリ名
+	UserConfigFile  = "config.toml"       // ユーザー設定ディレクトリ内の設定ファイル名
+
+	profileSection = "profiles" // プロファイルのセクション名 ([profiles.<名前>])
+)
+
+// 設定の取得元（優先度の低い順）
+const (
+	SourceDefault = "default" // フラグのデフォルト値
+	SourceFile    = "file"    // 設定ファイルのデフォルト値
+	SourceProfile = "profile" // 設定ファイルのプロファイル
+	SourceEnv     = "env"     // 環境変数 (STEAM_REVIEW_*)
+	SourceFlag    = "flag"    // コマンドライン引数
+)
+
+// TargetKeys 取得対象のゲームを指定するキー
+//
+// 優先度の高い取得元でいずれかが指定された場合、低い取得元の値はすべて無視する。
+var TargetKeys = []string{"appid", "game", "games"}
+
+// FileKeys 設定ファイルで指定できるキー（フラグ名と同じ）
+var FileKeys = []string{
+	"appid", "game", "games", "max", "lang", "filter", "split", "json", "unanswered",
+	"summary-file",
+	"output", "verbose", "quiet", "log-level", "log-format", "locale",
+	"playtime-buckets", "keywords", "keywords-top", "stopwords", "sentiment", "lexicon-dir",
+	"top-helpful", "stats-format", "stats-file", "charts", "chart-style",
+	"format",
+	"appids", "interval",
+	"webhook", "webhook-format", "webhook-template", "webhook-batch", "webhook-retries",
+	"notify-on", "notify-negative", "notify-keywords",
+}
+
+// File 設定ファイルの内容
+//
+// 値はフラグと同じ文字列表現で保持する（配列はカンマ区切りに変換）。
+type File struct {
+	Path     string                       // 読み込んだファイルのパス
+	Defaults map[string]string            // すべてのコマンドに適用するデフォルト値
+	Profiles map[string]map[string]string // 名前付きプロファイル
+}
+
+// FindFile 設定ファイルを検索
+//
+// path が指定された場合はそのファイルを使用する。指定されていない場合は
+// カレントディレクトリの steam-review.toml、ユーザー設定ディレクトリ
+// ($XDG_CONFIG_HOME/steam-review/config.toml など) の順に検索し、見つからなければ空文字列を返す。
+func FindFile(path string) (string, error) {
+	if path != "" {
+		if _, err := os.Stat(path); err != nil {
+			return "", err
+		}
+		return path, nil
+	}
+
+	candidates := []string{LocalConfigFile}
+	if dir, err := os.UserConfigDir(); err == nil {
+		candidates = append(candidates, filepath.Join(dir, UserConfigDir, UserConfigFile))
+	}
+	for _, candidate := range candidates {
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return candidate, nil
+		}
+	}
+	return "", nil
+}
+
+// LoadFile 設定ファイルを読み込む
+func LoadFile(path string) (*File, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	f, err := ParseFile(file)
+	if err != nil {
+		return nil, errors.New(i18n.Tf(i18n.MsgErrorConfigParse, path, err))
+	}
+	f.Path = path
+	return f, nil
+}
+
+// ParseFile TOMLのサブセットで書かれた設定を解析
+//
+// 対応する構文は、コメント (#)、トップレベルのキー (デフォルト値)、
+// [profiles.<名前>] セクション、文字列 ("基本文字列" と 'リテラル文字列')・整数・真偽値・
+// 文字列や整数の配列（複数行に分けて書いてもよい）の値。
+func ParseFile(r io.Reader) (*File, error) {
+	f := &File{
+		Defaults: make(map[string]string),
+		Profiles: make(map[string]map[string]string),
+	}
+	current := f.Defaults
+
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(stripComment(scanner.Text()))
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			name, err := parseSection(line)
+			if err != nil {
+				return nil, lineError(lineNo, err)
+			}
+			if _, ok := f.Profiles[name]; ok {
+				return nil, lineError(lineNo, errors.New(i18n.Tf(i18n.MsgErrorConfigDuplicate, name)))
+			}
+			current = make(map[string]string)
+			f.Profiles[name] = current
+			continue
+		}
+
+		key, rawValue, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, lineError(lineNo, errors.New(i18n.Tf(i18n.MsgErrorConfigSyntax, line)))
+		}
+		key = unquote(strings.TrimSpace(key))
+		if !isFileKey(key) {
+			return nil, lineError(lineNo, errors.New(i18n.Tf(i18n.MsgErrorConfigKey, key)))
+		}
+		if _, ok := current[key]; ok {
+			return nil, lineError(lineNo, errors.New(i18n.Tf(i18n.MsgErrorConfigDuplicate, key)))
+		}
+		rawValue = strings.TrimSpace(rawValue)
+		startLine := lineNo
+		// 閉じていない配列は、閉じ括弧のある行までを1つの値として扱う
+		if strings.HasPrefix(rawValue, "[") {
+			for indexOutsideString(rawValue, ']') < 0 && scanner.Scan() {
+				lineNo++
+				rawValue += " " + strings.TrimSpace(stripComment(scanner.Text()))
+			}
+		}
+		value, err := parseValue(rawValue)
+		if err != nil {
+			return nil, lineError(startLine, err)
+		}
+		current[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Settings デフォルト値にプロファイルを重ねた設定を返す
+//
+// 戻り値の sources には各キーの取得元 (SourceFile, SourceProfile) を格納する。
+func (f *File) Settings(profile string) (settings map[string]string, sources map[string]string, err error) {
+	settings = make(map[string]string)
+	sources = make(map[string]string)
+	if f == nil {
+		if profile != "" {
+			return nil, nil, errors.New(i18n.Tf(i18n.MsgErrorConfigNoFile, profile))
+		}
+		return settings, sources, nil
+	}
+
+	MergeSettings(settings, sources, f.Defaults, SourceFile)
+	if profile != "" {
+		values, ok := f.Profiles[profile]
+		if !ok {
+			return nil, nil, errors.New(i18n.Tf(i18n.MsgErrorConfigProfile, profile, strings.Join(f.ProfileNames(), ", ")))
+		}
+		MergeSettings(settings, sources, values, SourceProfile)
+	}
+	return settings, sources, nil
+}
+
+// ProfileNames プロファイル名を名前順で返す
+func (f *File) ProfileNames() []string {
+	names := make([]string, 0, len(f.Profiles))
+	for name := range f.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// MergeSettings settings に優先度の高い取得元の値を重ねる
+//
+// values に取得対象のキー (TargetKeys) が含まれる場合、既存の取得対象の指定は取り除く。
+func MergeSettings(settings, sources, values map[string]string, source string) {
+	if hasTargetKey(values) {
+		for _, key := range TargetKeys {
+			delete(settings, key)
+			delete(sources, key)
+		}
+	}
+	for key, value := range values {
+		settings[key] = value
+		sources[key] = source
+	}
+}
+
+// IsTargetKey 取得対象のゲームを指定するキーかどうかを判定
+func IsTargetKey(key string) bool {
+	for _, k := range TargetKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTargetKey 取得対象のゲームを指定するキーが含まれるかどうかを判定
+func hasTargetKey(values map[string]string) bool {
+	for key := range values {
+		if IsTargetKey(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// isFileKey 設定ファイルで指定できるキーかどうかを判定
+func isFileKey(key string) bool {
+	for _, k := range FileKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+// lineError 行番号を付けたエラーを作成
+func lineError(lineNo int, err error) error {
+	return errors.New(i18n.Tf(i18n.MsgErrorConfigLine, lineNo, err))
+}
+
+// stripComment 文字列の外にある # 以降を取り除く
+func stripComment(line string) string {
+	if i := indexOutsideString(line, '#'); i >= 0 {
+		return line[:i]
+	}
+	return line
+}
+
+// indexOutsideString 引用符で囲まれた文字列の外で最初に c が現れる位置を返す（見つからなければ -1）
+//
+// "..." の中ではバックスラッシュによるエスケープを考慮し、'...' の中ではエスケープを扱わない。
+func indexOutsideString(s string, c byte) int {
+	var quote byte // 文字列の中にいる場合はその引用符
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quote == '"' && s[i] == '\\':
+			i++
+		case quote != 0:
+			if s[i] == quote {
+				quote = 0
+			}
+		case s[i] == '"' || s[i] == '\'':
+			quote = s[i]
+		case s[i] == c:
+			return i
+		}
+	}
+	return -1
+}
+
+// parseSection [profiles.<名前>] 形式のセクション見出しからプロファイル名を取得
+func parseSection(line string) (string, error) {
+	if !strings.HasSuffix(line, "]") {
+		return "", errors.New(i18n.Tf(i18n.MsgErrorConfigSyntax, line))
+	}
+	header := strings.TrimSpace(line[1 : len(line)-1])
+	prefix, name, ok := strings.Cut(header, ".")
+	if !ok || strings.TrimSpace(prefix) != profileSection {
+		return "", errors.New(i18n.Tf(i18n.MsgErrorConfigSection, header))
+	}
+	name = unquote(strings.TrimSpace(name))
+	if name == "" {
+		return "", errors.New(i18n.Tf(i18n.MsgErrorConfigSection, header))
+	}
+	return name, nil
+}
+
+// parseValue 値を解析し、フラグに渡す文字列表現に変換
+func parseValue(raw string) (string, error) {
+	switch {
+	case raw == "":
+		return "", errors.New(i18n.Tf(i18n.MsgErrorConfigValue, raw))
+	case strings.HasPrefix(raw, "["):
+		if !strings.HasSuffix(raw, "]") {
+			return "", errors.New(i18n.Tf(i18n.MsgErrorConfigValue, raw))
+		}
+		var items []string
+		for _, item := range splitArray(raw[1 : len(raw)-1]) {
+			item = strings.TrimSpace(item)
+			if item == "" {
+				continue
+			}
+			value, err := parseScalar(item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, value)
+		}
+		return strings.Join(items, ","), nil
+	default:
+		return parseScalar(raw)
+	}
+}
+
+// parseScalar 文字列・整数・真偽値を解析
+func parseScalar(raw string) (string, error) {
+	if strings.HasPrefix(raw, "'") {
+		// リテラル文字列はエスケープを解釈しない（Windows のパスなど）
+		value, ok := literalString(raw)
+		if !ok {
+			return "", errors.New(i18n.Tf(i18n.MsgErrorConfigValue, raw))
+		}
+		return value, nil
+	}
+	if strings.HasPrefix(raw, `"`) {
+		value, err := strconv.Unquote(raw)
+		if err != nil {
+			return "", errors.New(i18n.Tf(i18n.MsgErrorConfigValue, raw))
+		}
+		return value, nil
+	}
+	if raw == "true" || raw == "false" {
+		return raw, nil
+	}
+	if _, err := strconv.Atoi(raw); err == nil {
+		return raw, nil
+	}
+	return "", errors.New(i18n.Tf(i18n.MsgErrorConfigValue, raw))
+}
+
+// splitArray 配列の中身を文字列の外にあるカンマで分割
+func splitArray(s string) []string {
+	var items []string
+	for {
+		i := indexOutsideString(s, ',')
+		if i < 0 {
+			return append(items, s)
+		}
+		items = append(items, s[:i])
+		s = s[i+1:]
+	}
+}
+
+// unquote 引用符で囲まれていれば取り除く
+func unquote(s string) string {
+	if value, ok := literalString(s); ok {
+		return value
+	}
+	if value, err := strconv.Unquote(s); err == nil {
+		return value
+	}
+	return s
+}
+
+// literalString 'リテラル文字列' の中身を返す（リテラル文字列でなければ false）
+func literalString(s string) (string, bool) {
+	if len(s) < 2 || s[0] != '\'' || s[len(s)-1] != '\'' || strings.Contains(s[1:len(s)-1], "'") {
+		return "", false
+	}
+	return s[1 : len(s)-1], true
+}
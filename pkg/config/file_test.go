@@ -0,0 +1,191 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+const sampleFile = `
+# デフォルト値
+lang = ["japanese", "english"] # 末尾のコメント
+output = "reviews # not a comment"
+appid = "440"
+
+[profiles.jp-daily]
+lang = "japanese"
+max = 200
+json = true
+games = ["730", "Elden Ring"]
+playtime-buckets = [2, 10]
+
+[profiles."weekly report"]
+filter = "recent"
+`
+
+func TestParseFile(t *testing.T) {
+	f, err := ParseFile(strings.NewReader(sampleFile))
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	defaults := map[string]string{
+		"lang":   "japanese,english",
+		"output": "reviews # not a comment",
+		"appid":  "440",
+	}
+	for key, want := range defaults {
+		if got := f.Defaults[key]; got != want {
+			t.Errorf("Defaults[%q] = %q, want %q", key, got, want)
+		}
+	}
+
+	profile, ok := f.Profiles["jp-daily"]
+	if !ok {
+		t.Fatal("profile jp-daily not found")
+	}
+	want := map[string]string{
+		"lang":             "japanese",
+		"max":              "200",
+		"json":             "true",
+		"games":            "730,Elden Ring",
+		"playtime-buckets": "2,10",
+	}
+	for key, value := range want {
+		if got := profile[key]; got != value {
+			t.Errorf("jp-daily[%q] = %q, want %q", key, got, value)
+		}
+	}
+
+	if names := f.ProfileNames(); len(names) != 2 || names[0] != "jp-daily" || names[1] != "weekly report" {
+		t.Errorf("ProfileNames() = %v", names)
+	}
+}
+
+func TestParseFileMultilineAndLiteral(t *testing.T) {
+	input := `
+lang = [
+  "japanese", # コメント
+  'english',
+]
+output = 'C:\reviews\steam'
+stopwords = 'words #1.txt'
+
+[profiles.'win']
+games = ['730',
+  "Elden Ring, GOTY"]
+max = 50
+`
+	f, err := ParseFile(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	defaults := map[string]string{
+		"lang":      "japanese,english",
+		"output":    `C:\reviews\steam`,
+		"stopwords": "words #1.txt",
+	}
+	for key, want := range defaults {
+		if got := f.Defaults[key]; got != want {
+			t.Errorf("Defaults[%q] = %q, want %q", key, got, want)
+		}
+	}
+	profile := f.Profiles["win"]
+	if profile["games"] != "730,Elden Ring, GOTY" || profile["max"] != "50" {
+		t.Errorf("win profile = %v", profile)
+	}
+}
+
+func TestParseFileErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "Unknown key", input: "foo = 1"},
+		{name: "Missing equals", input: "lang"},
+		{name: "Bare string", input: "lang = japanese"},
+		{name: "Unterminated array", input: `lang = ["japanese"`},
+		{name: "Unterminated multiline array", input: "lang = [\n\"japanese\",\nmax = 1"},
+		{name: "Unterminated literal string", input: "output = 'reviews"},
+		{name: "Duplicate key", input: "max = 1\nmax = 2"},
+		{name: "Unsupported section", input: "[settings]"},
+		{name: "Duplicate profile", input: "[profiles.a]\n[profiles.a]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseFile(strings.NewReader(tt.input)); err == nil {
+				t.Errorf("ParseFile(%q) error = nil, want error", tt.input)
+			}
+		})
+	}
+}
+
+func TestSettings(t *testing.T) {
+	f, err := ParseFile(strings.NewReader(sampleFile))
+	if err != nil {
+		t.Fatalf("ParseFile() error = %v", err)
+	}
+
+	settings, sources, err := f.Settings("jp-daily")
+	if err != nil {
+		t.Fatalf("Settings() error = %v", err)
+	}
+	if settings["lang"] != "japanese" || sources["lang"] != SourceProfile {
+		t.Errorf("lang = %q (%s), want profile value", settings["lang"], sources["lang"])
+	}
+	if settings["output"] != "reviews # not a comment" || sources["output"] != SourceFile {
+		t.Errorf("output = %q (%s), want file value", settings["output"], sources["output"])
+	}
+	// プロファイルの games がファイルの appid を置き換える
+	if _, ok := settings["appid"]; ok {
+		t.Errorf("appid should be removed when the profile specifies games")
+	}
+
+	if _, _, err := f.Settings("missing"); err == nil {
+		t.Error("Settings(missing) error = nil, want error")
+	}
+
+	var none *File
+	if settings, _, err := none.Settings(""); err != nil || len(settings) != 0 {
+		t.Errorf("nil File Settings() = %v, %v", settings, err)
+	}
+	if _, _, err := none.Settings("jp-daily"); err == nil {
+		t.Error("nil File Settings(jp-daily) error = nil, want error")
+	}
+}
+
+func TestFindFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "custom.toml")
+	if err := os.WriteFile(path, []byte("max = 10\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := FindFile(path); err != nil || got != path {
+		t.Errorf("FindFile(%q) = %q, %v", path, got, err)
+	}
+	if _, err := FindFile(filepath.Join(dir, "missing.toml")); err == nil {
+		t.Error("FindFile(missing) error = nil, want error")
+	}
+
+	// ユーザー設定ディレクトリが XDG_CONFIG_HOME に従うのは Linux などのみ
+	if runtime.GOOS == "darwin" || runtime.GOOS == "windows" || runtime.GOOS == "plan9" {
+		return
+	}
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+	userFile := filepath.Join(dir, UserConfigDir, UserConfigFile)
+	if err := os.MkdirAll(filepath.Dir(userFile), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(userFile, []byte("max = 10\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := FindFile(""); err != nil || got != userFile {
+		t.Errorf("FindFile(\"\") = %q, %v, want %q", got, err, userFile)
+	}
+}
@@ -0,0 +1,182 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// LanguageAll すべての言語のレビューを取得する指定
+const LanguageAll = "all"
+
+// Language Steam APIの言語
+type Language struct {
+	Code    string   // Steam APIの言語コード (例: "schinese")
+	ISO     string   // ISO 639-1 / BCP 47 の言語タグ (例: "zh-CN")
+	Aliases []string // 言語コード・言語タグの別表記 (例: "zh", "zh-Hans")
+	NameEN  string   // 英語の表示名
+	NameJA  string   // 日本語の表示名
+}
+
+// Languages Steam APIで使用できる言語の一覧
+var Languages = []Language{
+	{Code: "arabic", ISO: "ar", NameEN: "Arabic", NameJA: "アラビア語"},
+	{Code: "bulgarian", ISO: "bg", NameEN: "Bulgarian", NameJA: "ブルガリア語"},
+	{Code: "schinese", ISO: "zh-CN", Aliases: []string{"zh", "zh-Hans", "zh-SG"}, NameEN: "Simplified Chinese", NameJA: "簡体字中国語"},
+	{Code: "tchinese", ISO: "zh-TW", Aliases: []string{"zh-Hant", "zh-HK"}, NameEN: "Traditional Chinese", NameJA: "繁体字中国語"},
+	{Code: "czech", ISO: "cs", NameEN: "Czech", NameJA: "チェコ語"},
+	{Code: "danish", ISO: "da", NameEN: "Danish", NameJA: "デンマーク語"},
+	{Code: "dutch", ISO: "nl", NameEN: "Dutch", NameJA: "オランダ語"},
+	{Code: "english", ISO: "en", Aliases: []string{"en-US", "en-GB"}, NameEN: "English", NameJA: "英語"},
+	{Code: "finnish", ISO: "fi", NameEN: "Finnish", NameJA: "フィンランド語"},
+	{Code: "french", ISO: "fr", Aliases: []string{"fr-FR"}, NameEN: "French", NameJA: "フランス語"},
+	{Code: "german", ISO: "de", Aliases: []string{"de-DE"}, NameEN: "German", NameJA: "ドイツ語"},
+	{Code: "greek", ISO: "el", NameEN: "Greek", NameJA: "ギリシャ語"},
+	{Code: "hungarian", ISO: "hu", NameEN: "Hungarian", NameJA: "ハンガリー語"},
+	{Code: "indonesian", ISO: "id", NameEN: "Indonesian", NameJA: "インドネシア語"},
+	{Code: "italian", ISO: "it", NameEN: "Italian", NameJA: "イタリア語"},
+	{Code: "japanese", ISO: "ja", Aliases: []string{"ja-JP"}, NameEN: "Japanese", NameJA: "日本語"},
+	{Code: "koreana", ISO: "ko", Aliases: []string{"ko-KR"}, NameEN: "Korean", NameJA: "韓国語"},
+	{Code: "norwegian", ISO: "no", Aliases: []string{"nb", "nn"}, NameEN: "Norwegian", NameJA: "ノルウェー語"},
+	{Code: "polish", ISO: "pl", NameEN: "Polish", NameJA: "ポーランド語"},
+	{Code: "portuguese", ISO: "pt", Aliases: []string{"pt-PT"}, NameEN: "Portuguese", NameJA: "ポルトガル語"},
+	{Code: "brazilian", ISO: "pt-BR", NameEN: "Portuguese (Brazil)", NameJA: "ポルトガル語 (ブラジル)"},
+	{Code: "romanian", ISO: "ro", NameEN: "Romanian", NameJA: "ルーマニア語"},
+	{Code: "russian", ISO: "ru", NameEN: "Russian", NameJA: "ロシア語"},
+	{Code: "spanish", ISO: "es", Aliases: []string{"es-ES"}, NameEN: "Spanish (Spain)", NameJA: "スペイン語 (スペイン)"},
+	{Code: "latam", ISO: "es-419", Aliases: []string{"es-MX", "es-AR", "es-CO", "es-CL"}, NameEN: "Spanish (Latin America)", NameJA: "スペイン語 (中南米)"},
+	{Code: "swedish", ISO: "sv", NameEN: "Swedish", NameJA: "スウェーデン語"},
+	{Code: "thai", ISO: "th", NameEN: "Thai", NameJA: "タイ語"},
+	{Code: "turkish", ISO: "tr", NameEN: "Turkish", NameJA: "トルコ語"},
+	{Code: "ukrainian", ISO: "uk", NameEN: "Ukrainian", NameJA: "ウクライナ語"},
+	{Code: "vietnamese", ISO: "vi", NameEN: "Vietnamese", NameJA: "ベトナム語"},
+}
+
+// IsSteamLanguage Steam APIの言語コード（または "all"）かどうかを判定
+func IsSteamLanguage(code string) bool {
+	if strings.EqualFold(code, LanguageAll) {
+		return true
+	}
+	for _, lang := range Languages {
+		if lang.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// LookupLanguage Steam APIの言語コード・ISO/BCP 47 の言語タグ・英語名から言語を検索
+//
+// 大文字小文字と区切り文字 ("_" と "-") の違いは無視する (例: "ja", "zh_TW", "pt-BR", "Korean")。
+func LookupLanguage(s string) (Language, bool) {
+	key := normalizeLanguageKey(s)
+	if key == "" {
+		return Language{}, false
+	}
+	for _, lang := range Languages {
+		if lang.Code == key || normalizeLanguageKey(lang.ISO) == key || strings.ToLower(lang.NameEN) == key {
+			return lang, true
+		}
+		for _, alias := range lang.Aliases {
+			if normalizeLanguageKey(alias) == key {
+				return lang, true
+			}
+		}
+	}
+	return Language{}, false
+}
+
+// NormalizeLanguages 言語の指定をSteam APIの言語コードに変換
+//
+// "all" はそのまま残し、不明な指定は変換せずに残す（Validate で検出する）。重複は取り除く。
+func NormalizeLanguages(languages []string) []string {
+	var normalized []string
+	seen := make(map[string]bool)
+	for _, s := range languages {
+		code := s
+		if strings.EqualFold(s, LanguageAll) {
+			code = LanguageAll
+		} else if lang, ok := LookupLanguage(s); ok {
+			code = lang.Code
+		}
+		if !seen[code] {
+			seen[code] = true
+			normalized = append(normalized, code)
+		}
+	}
+	return normalized
+}
+
+// LanguageISO Steam APIの言語コードに対応する言語タグを返す（不明な場合は空文字列）
+func LanguageISO(code string) string {
+	if lang, ok := LookupLanguage(code); ok {
+		return lang.ISO
+	}
+	return ""
+}
+
+// DisplayName 現在の表示言語での言語名
+func (l Language) DisplayName() string {
+	if i18n.GetCurrentLanguage() == "ja" {
+		return l.NameJA
+	}
+	return l.NameEN
+}
+
+// LanguageLabel 表示用の言語名を "日本語 (japanese)" の形式で返す（不明な言語コードはそのまま）
+func LanguageLabel(code string) string {
+	if lang, ok := LookupLanguage(code); ok {
+		return lang.DisplayName() + " (" + lang.Code + ")"
+	}
+	return code
+}
+
+// SuggestLanguage 入力に最も近いSteam APIの言語コードを返す（近いものがなければ空文字列）
+func SuggestLanguage(input string) string {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if input == "" {
+		return ""
+	}
+
+	// 編集距離が入力の長さの半分以下（最大3）のものだけを候補にする
+	maxDistance := len(input) / 2
+	if maxDistance > 3 {
+		maxDistance = 3
+	}
+
+	best := ""
+	bestDistance := maxDistance + 1
+	for _, lang := range Languages {
+		if d := editDistance(input, lang.Code); d < bestDistance {
+			best, bestDistance = lang.Code, d
+		}
+	}
+	return best
+}
+
+// normalizeLanguageKey 比較用に言語の指定を正規化
+func normalizeLanguageKey(s string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(s)), "_", "-")
+}
+
+// editDistance 2つの文字列のレーベンシュタイン距離を計算
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
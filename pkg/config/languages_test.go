@@ -0,0 +1,102 @@
+package config
+
+import "testing"
+
+func TestIsSteamLanguage(t *testing.T) {
+	for _, code := range []string{"japanese", "schinese", "koreana", "latam", "all", "ALL"} {
+		if !IsSteamLanguage(code) {
+			t.Errorf("IsSteamLanguage(%q) = false, want true", code)
+		}
+	}
+	for _, code := range []string{"", "korean", "Japanese", "jp"} {
+		if IsSteamLanguage(code) {
+			t.Errorf("IsSteamLanguage(%q) = true, want false", code)
+		}
+	}
+}
+
+func TestSuggestLanguage(t *testing.T) {
+	tests := map[string]string{
+		"japanes":   "japanese",
+		"Japanese":  "japanese",
+		"korean":    "koreana",
+		"chinese":   "schinese",
+		"portugese": "portuguese",
+		"jp":        "",
+		"klingon":   "",
+		"":          "",
+	}
+	for input, want := range tests {
+		if got := SuggestLanguage(input); got != want {
+			t.Errorf("SuggestLanguage(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestLookupLanguage(t *testing.T) {
+	tests := map[string]string{
+		"japanese": "japanese",
+		"ja":       "japanese",
+		"JA":       "japanese",
+		"zh-TW":    "tchinese",
+		"zh_tw":    "tchinese",
+		"zh-Hans":  "schinese",
+		"pt-BR":    "brazilian",
+		"pt":       "portuguese",
+		"es-419":   "latam",
+		"Korean":   "koreana",
+		"nb":       "norwegian",
+	}
+	for input, want := range tests {
+		lang, ok := LookupLanguage(input)
+		if !ok || lang.Code != want {
+			t.Errorf("LookupLanguage(%q) = %q, %v, want %q", input, lang.Code, ok, want)
+		}
+	}
+	for _, input := range []string{"", "all", "xx", "klingon"} {
+		if _, ok := LookupLanguage(input); ok {
+			t.Errorf("LookupLanguage(%q) found a language, want none", input)
+		}
+	}
+}
+
+func TestLanguageCatalog(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, lang := range Languages {
+		if lang.Code == "" || lang.ISO == "" || lang.NameEN == "" || lang.NameJA == "" {
+			t.Errorf("incomplete catalog entry: %+v", lang)
+		}
+		for _, key := range append([]string{lang.Code, lang.ISO}, lang.Aliases...) {
+			k := normalizeLanguageKey(key)
+			if seen[k] {
+				t.Errorf("duplicate language key %q", key)
+			}
+			seen[k] = true
+		}
+	}
+}
+
+func TestNormalizeLanguages(t *testing.T) {
+	got := NormalizeLanguages([]string{"ja", "japanese", "zh-TW", "ALL", "xx"})
+	want := []string{"japanese", "tchinese", "all", "xx"}
+	if len(got) != len(want) {
+		t.Fatalf("NormalizeLanguages() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("NormalizeLanguages()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestLanguageISOAndLabel(t *testing.T) {
+	if got := LanguageISO("brazilian"); got != "pt-BR" {
+		t.Errorf("LanguageISO(brazilian) = %q, want pt-BR", got)
+	}
+	if got := LanguageISO("unknown"); got != "" {
+		t.Errorf("LanguageISO(unknown) = %q, want empty", got)
+	}
+	if got := LanguageLabel("unknown"); got != "unknown" {
+		t.Errorf("LanguageLabel(unknown) = %q, want unknown", got)
+	}
+}
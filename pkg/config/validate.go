@@ -0,0 +1,175 @@
+package config
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// FieldError 設定項目ごとの検証エラー
+type FieldError struct {
+	Field   string // 対応するフラグ名 (例: "lang")
+	Value   string // 指定された値
+	Message string // 翻訳済みの説明
+}
+
+func (e *FieldError) Error() string {
+	return i18n.Tf(i18n.MsgErrorInvalidField, e.Field, e.Value, e.Message)
+}
+
+// ValidationError 設定の検証エラーの一覧
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		messages[i] = fe.Error()
+	}
+	return strings.Join(messages, "\n")
+}
+
+// result 検証エラーがあれば e、なければ nil を返す
+func (e *ValidationError) result() error {
+	if len(e.Errors) > 0 {
+		return e
+	}
+	return nil
+}
+
+// add 検証エラーを追加
+func (e *ValidationError) add(field, value, message string) {
+	e.Errors = append(e.Errors, &FieldError{Field: field, Value: value, Message: message})
+}
+
+// Validate 設定値を検証し、不正な項目があれば *ValidationError を返す
+//
+// 通信を始める前に呼び出す。空のフィルターと統計の出力形式はデフォルト値として扱う。
+// 取得間隔は監視するゲーム (AppIDs) が指定されている場合のみ検証する。
+func (c Config) Validate() error {
+	return c.validate().result()
+}
+
+// ValidateFetch Validate に加えて、fetch だけで使う設定の組み合わせを検証
+//
+// fetch の Webhook 通知は前回保存した1つのJSONファイルと比較して新しいレビューを判定するため、
+// -json が必要で -split とは併用できない。
+func (c Config) ValidateFetch() error {
+	ve := c.validate()
+	if c.Webhook != "" {
+		if !c.OutputJSON {
+			ve.add("json", "false", i18n.T(i18n.MsgValidationWebhookJSON))
+		}
+		if c.SplitByLang {
+			ve.add("split", "true", i18n.T(i18n.MsgValidationWebhookSplit))
+		}
+	}
+	return ve.result()
+}
+
+// validate すべてのコマンドに共通の検証
+func (c Config) validate() *ValidationError {
+	ve := &ValidationError{}
+
+	if c.AppID != "" && !isNumeric(c.AppID) {
+		ve.add("appid", c.AppID, i18n.T(i18n.MsgValidationAppID))
+	}
+	if c.AppID != "" && c.GameName != "" {
+		ve.add("game", c.GameName, i18n.T(i18n.MsgValidationBothInputs))
+	}
+
+	if c.MaxReviews < 0 {
+		ve.add("max", strconv.Itoa(c.MaxReviews), i18n.T(i18n.MsgValidationNonNegative))
+	}
+
+	for _, lang := range c.Languages {
+		if _, ok := LookupLanguage(lang); ok || IsSteamLanguage(lang) {
+			continue
+		}
+		if suggestion := SuggestLanguage(lang); suggestion != "" {
+			ve.add("lang", lang, i18n.Tf(i18n.MsgValidationLanguageSuggest, suggestion))
+		} else {
+			ve.add("lang", lang, i18n.T(i18n.MsgValidationLanguage))
+		}
+	}
+
+	switch c.Filter {
+	case "", FilterAll, FilterRecent, FilterUpdated:
+	default:
+		ve.add("filter", c.Filter, i18n.Tf(i18n.MsgValidationOneOf, strings.Join([]string{FilterAll, FilterRecent, FilterUpdated}, ", ")))
+	}
+
+	if c.Keywords && c.KeywordsTop <= 0 {
+		ve.add("keywords-top", strconv.Itoa(c.KeywordsTop), i18n.T(i18n.MsgValidationPositive))
+	}
+	if c.TopHelpful < 0 {
+		ve.add("top-helpful", strconv.Itoa(c.TopHelpful), i18n.T(i18n.MsgValidationNonNegative))
+	}
+
+	switch c.StatsFormat {
+	case "", StatsFormatText, StatsFormatJSON, StatsFormatCSV:
+	default:
+		ve.add("stats-format", c.StatsFormat, i18n.Tf(i18n.MsgValidationOneOf, strings.Join([]string{StatsFormatText, StatsFormatJSON, StatsFormatCSV}, ", ")))
+	}
+
+	if c.Webhook != "" {
+		c.validateWebhook(ve)
+	}
+
+	for _, appID := range c.AppIDs {
+		if !isNumeric(appID) {
+			ve.add("appids", appID, i18n.T(i18n.MsgValidationAppIDs))
+		}
+	}
+	if len(c.AppIDs) > 0 && c.WatchInterval < MinWatchInterval {
+		ve.add("interval", c.WatchInterval.String(), i18n.Tf(i18n.MsgValidationInterval, MinWatchInterval))
+	}
+	return ve
+}
+
+// validateWebhook Webhook通知の設定を検証（Webhook URL が指定されている場合のみ）
+func (c Config) validateWebhook(ve *ValidationError) {
+	if u, err := url.Parse(c.Webhook); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		ve.add("webhook", c.Webhook, i18n.T(i18n.MsgValidationURL))
+	}
+	switch c.WebhookFormat {
+	case "", WebhookFormatJSON, WebhookFormatSlack, WebhookFormatDiscord:
+	default:
+		ve.add("webhook-format", c.WebhookFormat, i18n.Tf(i18n.MsgValidationOneOf, strings.Join([]string{WebhookFormatJSON, WebhookFormatSlack, WebhookFormatDiscord}, ", ")))
+	}
+	if c.WebhookBatch <= 0 {
+		ve.add("webhook-batch", strconv.Itoa(c.WebhookBatch), i18n.T(i18n.MsgValidationPositive))
+	}
+	if c.WebhookRetries < 0 {
+		ve.add("webhook-retries", strconv.Itoa(c.WebhookRetries), i18n.T(i18n.MsgValidationNonNegative))
+	}
+
+	for _, event := range c.NotifyOn {
+		switch event {
+		case NotifyNew:
+		case NotifyNegative:
+			if c.NotifyNegative <= 0 {
+				ve.add("notify-negative", strconv.Itoa(c.NotifyNegative), i18n.T(i18n.MsgValidationPositive))
+			}
+		case NotifyKeywords:
+			if len(c.NotifyKeywords) == 0 {
+				ve.add("notify-keywords", "", i18n.T(i18n.MsgValidationNotifyKeywords))
+			}
+		default:
+			ve.add("notify-on", event, i18n.Tf(i18n.MsgValidationOneOf, strings.Join([]string{NotifyNew, NotifyNegative, NotifyKeywords}, ", ")))
+		}
+	}
+}
+
+// isNumeric 文字列が数字のみで構成されるかどうかを判定
+func isNumeric(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,115 @@
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	valid := Config{
+		AppID:       "440",
+		MaxReviews:  100,
+		Languages:   []string{"japanese", "all"},
+		Filter:      FilterRecent,
+		StatsFormat: StatsFormatJSON,
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate() error = %v, want nil", err)
+	}
+	if err := (Config{}).Validate(); err != nil {
+		t.Fatalf("zero Config Validate() error = %v, want nil", err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		field  string
+	}{
+		{name: "Non-numeric App ID", modify: func(c *Config) { c.AppID = "tf2" }, field: "appid"},
+		{name: "App ID and game name", modify: func(c *Config) { c.GameName = "Team Fortress 2" }, field: "game"},
+		{name: "Negative max", modify: func(c *Config) { c.MaxReviews = -1 }, field: "max"},
+		{name: "Unknown language", modify: func(c *Config) { c.Languages = []string{"klingon"} }, field: "lang"},
+		{name: "Unknown filter", modify: func(c *Config) { c.Filter = "newest" }, field: "filter"},
+		{name: "Keywords top", modify: func(c *Config) { c.Keywords = true }, field: "keywords-top"},
+		{name: "Negative top helpful", modify: func(c *Config) { c.TopHelpful = -1 }, field: "top-helpful"},
+		{name: "Unknown stats format", modify: func(c *Config) { c.StatsFormat = "xml" }, field: "stats-format"},
+		{name: "Non-numeric watch App ID", modify: func(c *Config) { c.AppIDs, c.WatchInterval = []string{"440", "tf2"}, DefaultWatchInterval }, field: "appids"},
+		{name: "Webhook URL", modify: func(c *Config) { c.Webhook, c.WebhookBatch = "hooks.example.com", 10 }, field: "webhook"},
+		{name: "Webhook format", modify: func(c *Config) { c.Webhook, c.WebhookBatch, c.WebhookFormat = "https://hooks.example.com", 10, "teams" }, field: "webhook-format"},
+		{name: "Unknown notify event", modify: func(c *Config) {
+			c.Webhook, c.WebhookBatch, c.NotifyOn = "https://hooks.example.com", 10, []string{"new", "mentions"}
+		}, field: "notify-on"},
+		{name: "Notify keywords", modify: func(c *Config) {
+			c.Webhook, c.WebhookBatch, c.NotifyOn = "https://hooks.example.com", 10, []string{"keywords"}
+		}, field: "notify-keywords"},
+		{name: "Short watch interval", modify: func(c *Config) { c.AppIDs, c.WatchInterval = []string{"440"}, 30*time.Second }, field: "interval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := valid
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			var ve *ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("Validate() error = %v, want *ValidationError", err)
+			}
+			if len(ve.Errors) != 1 || ve.Errors[0].Field != tt.field {
+				t.Errorf("Validate() errors = %v, want one error for %q", ve.Errors, tt.field)
+			}
+		})
+	}
+}
+
+func TestValidateCollectsAllErrors(t *testing.T) {
+	cfg := Config{AppID: "x", MaxReviews: -5, Languages: []string{"japanes", "englsh"}, Filter: "top"}
+	var ve *ValidationError
+	if err := cfg.Validate(); !errors.As(err, &ve) {
+		t.Fatalf("Validate() error = %v, want *ValidationError", err)
+	}
+	if len(ve.Errors) != 5 {
+		t.Errorf("Validate() returned %d errors, want 5: %v", len(ve.Errors), ve)
+	}
+}
+
+func TestValidateFetchWebhook(t *testing.T) {
+	base := Config{AppID: "440", Webhook: "https://hooks.example.com", WebhookBatch: 10}
+
+	tests := []struct {
+		name   string
+		modify func(*Config)
+		fields []string
+	}{
+		{name: "JSON output", modify: func(c *Config) { c.OutputJSON = true }},
+		{name: "Text output", modify: func(c *Config) {}, fields: []string{"json"}},
+		{name: "Split by language", modify: func(c *Config) { c.OutputJSON, c.SplitByLang = true, true }, fields: []string{"split"}},
+		{name: "No webhook", modify: func(c *Config) { c.Webhook, c.SplitByLang = "", true }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := base
+			tt.modify(&cfg)
+			err := cfg.ValidateFetch()
+			if len(tt.fields) == 0 {
+				if err != nil {
+					t.Fatalf("ValidateFetch() error = %v, want nil", err)
+				}
+				return
+			}
+			var ve *ValidationError
+			if !errors.As(err, &ve) {
+				t.Fatalf("ValidateFetch() error = %v, want *ValidationError", err)
+			}
+			if len(ve.Errors) != len(tt.fields) || ve.Errors[0].Field != tt.fields[0] {
+				t.Errorf("ValidateFetch() errors = %v, want %v", ve.Errors, tt.fields)
+			}
+		})
+	}
+
+	// watch などの共通の検証では出力形式を問わない
+	if err := base.Validate(); err != nil {
+		t.Errorf("Validate() error = %v, want nil", err)
+	}
+}
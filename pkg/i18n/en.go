@@ -14,71 +14,532 @@ func getEnglishMessages() map[string]string {
 		"usage.full_text": `%s version %s
 
 Usage:
+  steam-review <command> [options] [arguments]
+  steam-review [fetch options]     (same as "steam-review fetch [options]")
+
+Run "steam-review help <command>" or "steam-review <command> -help" for the options of each command.
+`,
+		"usage.commands": "Commands:",
+		"usage.global_options": `Global options (available in every command):
+  -verbose            Show detailed logs
+  -quiet              Show no progress or log messages except errors (results such as statistics are still printed)
+  -log-level string   Minimum log level: debug, info, warn, error (default: info; -verbose means debug)
+  -log-format string  Log format: text, json (default: text)
+  -output string      Output directory (default: output)
+  -locale string      Display language: en, ja (default: detected from STEAM_REVIEW_LANG, LANG, etc.)
+  -config string      Config file (default: ./steam-review.toml, then the user config directory)
+  -profile string     Profile of the config file to use
+
+Exit codes:
+  0  Success
+  1  Other runtime error (e.g., reading input files)
+  2  Invalid command or arguments
+  3  Game not found
+  4  Network failure (Steam API request or response)
+  5  Write failure (review files, statistics, directories)
+  6  Partial success: some of several games failed, the others were saved
+  130  Interrupted by Ctrl+C (SIGINT) or SIGTERM; reviews fetched so far are saved marked as incomplete`,
+		"usage.environment": `Environment variables (override the config file, overridden by flags;
+the value format is the same as the option):`,
+		"usage.hint": `Run "steam-review help %s" for usage.`,
+
+		"command.fetch":      "Fetch reviews, save them to files and show statistics",
+		"command.stats":      "Show statistics for review files saved with -json",
+		"command.search":     "Search games by name and show their App IDs",
+		"command.export":     "Convert review files saved with -json to text, JSON or CSV",
+		"command.details":    "Show store details of a game",
+		"command.compare":    "Compare statistics of several games side by side",
+		"command.watch":      "Poll games for new reviews and save them continuously",
+		"command.config":     "Show the effective configuration from config file, profile and flags",
+		"command.completion": "Print a shell completion script",
+		"command.version":    "Show version information",
+		"command.help":       "Show help for a command",
+
+		"usage.fetch": `%s version %s
+
+Usage:
+  steam-review fetch [options]
+  steam-review fetch [options] -     (read App IDs or game names from standard input, one per line)
   steam-review [options]
 
 Options:
   -appid string         Steam App ID (e.g., 440)
   -game string          Game name (e.g., "Team Fortress 2")
+  -games string         Games to retrieve, used when -appid and -game are not given (comma-separated App IDs or names)
   -max int             Maximum number of reviews to retrieve (default: 100, 0 for unlimited)
-  -lang string         Languages to retrieve (comma-separated, default: japanese, e.g., "japanese,english")
-  -output string       Output directory (default: output)
+  -lang string         Languages to retrieve (comma-separated Steam codes or ISO/BCP 47 tags, default: japanese, e.g., "japanese,english" or "ja,zh-TW,pt-BR")
   -split              Split files by language
   -json               Output files in JSON format (.json) (default: text format)
-  -verbose            Show detailed logs
   -filter string      Review filter (recent: by creation date, updated: by update date, all: by helpfulness (default))
-  -help               Show this help
-  -version            Show version information
+  -playtime-buckets string  Playtime bucket boundaries in hours for statistics (comma-separated, default: "1,5,20,100")
+  -unanswered         Also save negative reviews without a developer response, sorted by helpfulness
+  -dry-run            Request only the first page and print the plan (request URL, expected reviews and time, files to write) without saving anything
+  -summary-file string  Write a JSON run summary (status, exit code, counts, saved files, duration, errors) to this file
+  -keywords           Analyze terms and bigrams typical of positive/negative reviews (also saved as CSV)
+  -keywords-top int   Number of top terms shown by keyword analysis (default: 20)
+  -stopwords string   File with additional stopwords for keyword analysis (one per line)
+  -sentiment          Score review text with a bundled sentiment lexicon and include it in output and statistics
+  -lexicon-dir string Directory of sentiment lexicons (<steam language>.tsv replaces the bundled one)
+  -top-helpful int    Number of most helpful positive/negative reviews shown in statistics (default: 3)
+  -stats-format string  Statistics output format: text, json, csv (default: text)
+                        json/csv on standard output: logs go to standard error, several games as JSON Lines / one CSV
+  -stats-file string    Write statistics to this file instead of standard output (one file per game with several games, e.g. stats_440.json)
+  -charts             Show charts with the statistics (only when stdout is a terminal)
+  -chart-style string Chart characters: unicode, ascii (default: unicode)
+
+Notification options:
+  -webhook string          Webhook URL to POST new reviews to (reviews not in the JSON file saved by the previous run; requires -json, not -split)
+  -webhook-format string   Body format: json, slack, discord (default: json)
+  -webhook-template string File with a Go text/template for the message body
+  -webhook-batch int       Maximum number of reviews per message (default: 10)
+  -webhook-retries int     Retries on network errors, 429 and 5xx, with doubling delay (default: 3)
+  -notify-on string        When to notify: new, negative, keywords (comma-separated, default: new)
+  -notify-negative int     Notify when at least this many new reviews are negative (default: 5)
+  -notify-keywords string  Notify about new reviews containing these keywords (comma-separated)
 
 Examples:
   # Get Japanese reviews by App ID (default: sorted by helpfulness)
-  steam-review -appid 440 -max 500 -verbose
+  steam-review fetch -appid 440 -max 500 -verbose
 
   # Get reviews sorted by creation date
-  steam-review -appid 440 -max 500 -filter recent -verbose
+  steam-review fetch -appid 440 -max 500 -filter recent -verbose
 
   # Get English reviews by game name
-  steam-review -game "Cyberpunk 2077" -lang "english" -max 1000 -output ./reviews
+  steam-review fetch -game "Cyberpunk 2077" -lang "english" -max 1000 -output ./reviews
 
   # Get reviews in multiple languages
-  steam-review -game "Elden Ring" -lang "japanese,english" -max 300 -split
+  steam-review fetch -game "Elden Ring" -lang "japanese,english" -max 300 -split
 
   # Save Japanese reviews in JSON format
-  steam-review -appid 570 -max 2000 -output ./dota2_reviews -json -verbose
+  steam-review fetch -appid 570 -max 2000 -output ./dota2_reviews -json -verbose
 
   # Get reviews in all languages
-  steam-review -appid 730 -lang "all" -max 1000 -split
+  steam-review fetch -appid 730 -lang "all" -max 1000 -split
 
   # Get recently updated reviews
-  steam-review -appid 730 -filter updated -max 200
+  steam-review fetch -appid 730 -filter updated -max 200
+
+  # Check the requests, expected time and output files before a large fetch
+  steam-review fetch -appid 730 -lang "all" -max 0 -split -dry-run
+
+  # Fetch the games listed in a file (blank lines and lines starting with # are ignored)
+  cat games.txt | steam-review fetch -json -
+
+  # Run from a scheduler and keep a machine-readable result
+  steam-review fetch -games 440,730 -json -quiet -summary-file output/summary.json
 
 Notes:
-  - Specify either App ID or game name, not both
+  - Specify either App ID or game name, not both (or a game list with -games, a profile or - for standard input)
   - If -lang is not specified, only Japanese reviews will be retrieved by default
   - Use "all" to retrieve reviews in all languages
   - Retrieving a large number of reviews may take time
   - Due to Steam API rate limits, there is a 1-second delay between requests`,
 
+		"usage.stats": `%s version %s
+
+Usage:
+  steam-review stats [options] <file.json> [<file.json>...]
+
+Computes statistics from review files saved with "fetch -json" without accessing Steam.
+Use - to read reviews from standard input (a saved file, a JSON array or JSON Lines with one review per line).
+With -stats-format json or csv on standard output, log messages go to standard error.
+Reviews with the same ID in several files (e.g. files split by language) are counted once.
+The statistics compare Early Access vs released, Steam purchase vs key activation and free-copy vs paid reviews with a significance hint (z-test, 10+ reviews per group).
+
+Options:
+  -playtime-buckets string  Playtime bucket boundaries in hours (comma-separated, default: "1,5,20,100")
+  -keywords           Analyze terms and bigrams typical of positive/negative reviews
+  -keywords-top int   Number of top terms shown by keyword analysis (default: 20)
+  -stopwords string   File with additional stopwords for keyword analysis (one per line)
+  -sentiment          Score review text with the sentiment lexicon (replaces saved scores)
+  -lexicon-dir string Directory of sentiment lexicons (<steam language>.tsv replaces the bundled one)
+  -top-helpful int    Number of most helpful positive/negative reviews shown (default: 3)
+  -stats-format string  Output format: text, json, csv (default: text)
+  -stats-file string    Write statistics to this file instead of standard output
+  -charts             Show charts (only when stdout is a terminal)
+  -chart-style string Chart characters: unicode, ascii (default: unicode)
+
+Examples:
+  steam-review stats -charts output/steam_reviews_440.json
+  steam-review stats -stats-format csv -stats-file stats.csv output/steam_reviews_440_*.json
+  jq -c '.reviews[] | select(.voted_up | not)' output/steam_reviews_440.json | steam-review stats -stats-format json -`,
+
+		"usage.search": `%s version %s
+
+Usage:
+  steam-review search [options] <game name>
+
+Lists games whose name contains the given text: exact matches first, then prefix matches, then others.
+
+Options:
+  -limit int          Maximum number of results (default: 20, 0 for unlimited)
+  -json               Print results as JSON
+
+Examples:
+  steam-review search "elden ring"`,
+
+		"usage.export": `%s version %s
+
+Usage:
+  steam-review export [options] <file.json> [<file.json>...]
+
+Converts review files saved with "fetch -json" into another format. Several files are merged into one.
+Use - to read reviews from standard input (a saved file, a JSON array or JSON Lines). The result is then
+written to standard output unless -file is given.
+
+Options:
+  -format string      Output format: text, json, csv, jsonl (default: csv)
+  -file string        Output file, - for standard output (default: <output directory>/<first input name>.<ext>)
+
+Examples:
+  steam-review export output/steam_reviews_440.json
+  steam-review export -format text -file reviews.txt output/steam_reviews_440.json
+  steam-review export -format jsonl -file - output/steam_reviews_440.json | jq -r '.review'`,
+
+		"usage.details": `%s version %s
+
+Usage:
+  steam-review details [options] <App ID | game name>
+
+Options:
+  -json               Print details as JSON
+
+Examples:
+  steam-review details 440
+  steam-review details -json "Team Fortress 2"`,
+
+		"usage.config": `%s version %s
+
+Usage:
+  steam-review config show [options]
+
+Shows the effective value and source (default, file, profile, env, flag) of each setting.
+Accepts the same options as fetch.
+
+Config file:
+  Searched in ./steam-review.toml, then <user config directory>/steam-review/config.toml
+  ($XDG_CONFIG_HOME or ~/.config on Linux), or given with -config.
+  Keys are the option names without "-". Top-level keys are defaults for every command,
+  [profiles.<name>] sections are selected with -profile.
+  Priority: flags > environment variables > profile > file defaults > built-in defaults.
+
+  lang = ["japanese", "english"]
+  output = "reviews"
+
+  [profiles.jp-daily]
+  lang = "japanese"
+  filter = "recent"
+  max = 200
+  json = true
+  games = ["440", "Elden Ring"]
+
+Examples:
+  steam-review config show -profile jp-daily
+  steam-review fetch -profile jp-daily`,
+
+		"usage.version": `%s version %s
+
+Usage:
+  steam-review version`,
+
+		"usage.help": `%s version %s
+
+Usage:
+  steam-review help [<command>]`,
+
+		"usage.compare": `%s version %s
+
+Usage:
+  steam-review compare [options] <target> <target> [<target>...]
+
+Each target is an App ID, a game name or a review file saved with -json.
+
+Options:
+  -max int             Maximum number of reviews to retrieve per game (default: 100, 0 for unlimited)
+  -lang string         Languages to retrieve (comma-separated Steam codes or ISO/BCP 47 tags, default: japanese)
+  -filter string       Review filter (recent, updated, all (default))
+  -csv string          Also save the comparison to this CSV file
+
+Examples:
+  # Compare two games by App ID
+  steam-review compare -lang all 440 730
+
+  # Compare saved review files and export as CSV
+  steam-review compare -csv compare.csv output/steam_reviews_440.json output/steam_reviews_730.json
+
+Notes:
+  - Options must come before the targets
+  - The Steam rating is the official all-language rating and is not available for saved files`,
+		"usage.watch": `%s version %s
+
+Usage:
+  steam-review watch -appids <appid,...> [options]
+
+Checks each game for new reviews (newest first, filter=recent) at a fixed interval and appends
+only reviews that are not saved yet to output/steam_reviews_<appid>.json. Runs until interrupted.
+
+Options:
+  -appids string       App IDs to watch (comma-separated, required)
+  -interval duration   Interval between checks (default: 15m, minimum: 1m)
+  -max int             Maximum number of reviews per game for the first check when nothing is saved yet (default: 100, 0 for unlimited)
+  -lang string         Languages to retrieve (comma-separated Steam codes or ISO/BCP 47 tags, default: japanese)
+
+Notification options:
+  -webhook string          Webhook URL to POST new reviews to (reviews added by each check)
+  -webhook-format string   Body format: json, slack, discord (default: json)
+  -webhook-template string File with a Go text/template for the message body
+  -webhook-batch int       Maximum number of reviews per message (default: 10)
+  -webhook-retries int     Retries on network errors, 429 and 5xx, with doubling delay (default: 3)
+  -notify-on string        When to notify: new, negative, keywords (comma-separated, default: new)
+  -notify-negative int     Notify when at least this many new reviews are negative (default: 5)
+  -notify-keywords string  Notify about new reviews containing these keywords (comma-separated)
+
+Examples:
+  # Check two games every 15 minutes
+  steam-review watch -appids 440,730
+
+  # Check every hour for reviews in any language
+  steam-review watch -appids 440 -interval 1h -lang all
+
+  # Post batches of new negative reviews to Slack
+  steam-review watch -appids 440 -webhook https://hooks.slack.com/services/... -webhook-format slack -notify-on negative -notify-negative 3
+
+Notes:
+  - Existing files saved with fetch -json are reused; reviews are de-duplicated by review ID
+  - On SIGINT/SIGTERM (Ctrl+C) the check in progress finishes and its file is written before exiting
+  - Failed checks are logged and retried at the next interval`,
+
+		"usage.completion": `%s version %s
+
+Usage:
+  steam-review completion bash|zsh|fish
+  steam-review completion apps
+
+Prints a completion script for the given shell. The script completes subcommands, all flags,
+the values of -filter, -format, -stats-format, -chart-style, -webhook-format, -notify-on,
+-locale and -lang (Steam language codes), and file and directory names.
+
+"completion apps" downloads the Steam app list and saves the game names to the user cache
+directory. When this file exists, the scripts also complete game names for -game, -games,
+search and details. Run it again to refresh the list.
+
+Setup:
+  # bash (~/.bashrc)
+  source <(steam-review completion bash)
+
+  # zsh (~/.zshrc, after compinit)
+  source <(steam-review completion zsh)
+
+  # fish
+  steam-review completion fish > ~/.config/fish/completions/steam-review.fish
+
+  # Optional: complete game names
+  steam-review completion apps`,
+
 		// Error messages
-		"error.no_input":           "Error: Please specify either App ID or game name",
-		"error.both_inputs":        "Error: Cannot specify both App ID and game name",
-		"error.dir_creation":       "Failed to create output directory: %v",
-		"error.review_fetch":       "Review fetch error: %v",
-		"error.file_save":          "File save error: %v",
-		"error.logger_init":        "Failed to initialize logger: %v",
-		"error.game_details_fetch": "Failed to fetch game details: %v",
+		"error.no_input":                 "Error: Please specify either App ID or game name",
+		"error.both_inputs":              "Error: Cannot specify both App ID and game name",
+		"error.dir_creation":             "Failed to create output directory: %v",
+		"error.review_fetch":             "Review fetch error: %v",
+		"error.file_save":                "File save error: %v",
+		"error.logger_init":              "Failed to initialize logger: %v",
+		"error.game_details_fetch":       "Failed to fetch game details: %v",
+		"error.playtime_buckets":         "Invalid playtime buckets %q: specify ascending positive hours (e.g., \"1,5,20,100\")",
+		"error.stopwords_load":           "Failed to load stopwords file: %v",
+		"error.sentiment_init":           "Failed to load sentiment lexicon: %v",
+		"error.lexicon_line":             "invalid lexicon line %d: %q",
+		"error.lexicon_load":             "%s: %v",
+		"error.lexicon_dir":              "No sentiment lexicons (*.tsv) found in -lexicon-dir %q",
+		"error.stats_format":             "Unknown statistics format %q (use text, json or csv)",
+		"error.stats_write":              "Failed to write statistics: %v",
+		"error.chart_style":              "Unknown chart style %q (use unicode or ascii)",
+		"error.log_level":                "Unknown log level %q (use debug, info, warn or error)",
+		"error.log_format":               "Unknown log format %q (use text or json)",
+		"error.compare_targets":          "Error: specify at least two App IDs, game names or saved JSON files to compare",
+		"error.compare_target":           "%s: %v",
+		"error.watch_no_appids":          "Error: specify the App IDs to watch with -appids",
+		"error.stdin_conflict":           "Error: - (read the games from standard input) cannot be combined with -appid or -game",
+		"error.stdin_read":               "Failed to read standard input: %v",
+		"error.completion_shell":         "Error: specify the shell (bash, zsh, fish) or \"apps\"",
+		"error.completion_shell_unknown": "Error: unsupported shell %q (bash, zsh, fish)",
+		"error.completion_apps":          "Failed to save the app list for completion: %v",
+		"completion.apps_saved":          "Saved %d game names for completion: %s",
+		"error.watch_poll":               "[%s] Failed to check for new reviews (retrying at the next check): %v",
+		"error.webhook_template":         "Webhook template error: %v",
+		"error.webhook_send":             "Webhook request error: %v",
+		"error.webhook_status":           "Webhook returned %s",
+		"error.notify":                   "[%s] Failed to send notification: %v",
+		"error.locale":                   "Unsupported display language: %q (available: en, ja)",
+		"error.unknown_command":          "Unknown command: %q",
+		"error.no_input_files":           "Specify one or more review files saved with -json",
+		"error.no_search_query":          "Specify a game name to search for",
+		"error.export_format":            "Unsupported export format: %q (available: text, json, csv, jsonl)",
+		"error.export_overwrite":         "Refusing to overwrite input file: %s",
+		"error.config_find":              "Config file not found: %v",
+		"error.config_parse":             "Failed to read config file %s: %v",
+		"error.config_line":              "line %d: %v",
+		"error.config_syntax":            "invalid syntax: %q",
+		"error.config_section":           "unsupported section %q (use [profiles.<name>])",
+		"error.config_key":               "unknown key %q",
+		"error.config_duplicate":         "%q is defined more than once",
+		"error.config_value":             "invalid value %q (use a quoted string, an integer, true/false or an array)",
+		"error.config_profile":           "Profile %q not found in the config file (available: %s)",
+		"error.config_no_file":           "Profile %q was specified but no config file was found",
+		"error.config_apply":             "Invalid value %q for %q in %s: %v",
+		"error.env_apply":                "Invalid value %q in environment variable %s: %v",
+		"error.invalid_field":            "Invalid -%s %q: %s",
+		"validation.appid":               "App ID must be numeric (use -game for a game name)",
+		"validation.appids":              "must be a numeric App ID",
+		"validation.both_inputs":         "cannot be combined with -appid; specify only one of them",
+		"validation.non_negative":        "must be 0 or greater",
+		"validation.positive":            "must be greater than 0",
+		"validation.one_of":              "must be one of: %s",
+		"validation.language":            "not a Steam language code or language tag (e.g. japanese, ja, zh-TW, pt-BR, all)",
+		"validation.language_suggest":    "not a Steam language code; did you mean %q?",
+		"validation.interval":            "must be at least %s",
+		"validation.url":                 "must be an http:// or https:// URL",
+		"validation.notify_keywords":     "required when -notify-on includes keywords",
+		"validation.webhook_json":        "fetch needs -json to detect new reviews (they are compared with the JSON file saved by the previous run)",
+		"validation.webhook_split":       "cannot be combined with -webhook on fetch (new reviews are compared with the single JSON file of the previous run)",
+		"error.config_command":           "Specify a config subcommand (show)",
+		"error.fetch_games":              "Failed to fetch %d of %d games",
+		"error.summary_write":            "Failed to write the run summary: %v",
 
 		// Success messages
 		"success.completed":  "Process completed",
 		"success.file_saved": "Reviews saved to %s",
 
 		// Statistics
-		"stats.title":              "=== Review Statistics ===",
-		"stats.game":               "Game: %s",
-		"stats.total_reviews":      "Total reviews: %d",
-		"stats.positive":           "Positive: %d (%.1f%%)",
-		"stats.negative":           "Negative: %d (%.1f%%)",
-		"stats.language_breakdown": "Review Statistics by Language:",
-		"stats.no_reviews":         "No reviews found",
+		"stats.title":                       "=== Review Statistics ===",
+		"stats.game":                        "Game: %s",
+		"stats.total_reviews":               "Total reviews: %d",
+		"stats.positive":                    "Positive: %d (%.1f%%)",
+		"stats.negative":                    "Negative: %d (%.1f%%)",
+		"stats.language_breakdown":          "Review Statistics by Language:",
+		"stats.no_reviews":                  "No reviews found",
+		"stats.playtime_breakdown":          "Review Statistics by Playtime at Review:",
+		"stats.playtime_bucket":             "  %s: %d reviews - Positive: %d (%.1f%%)",
+		"stats.playtime_median":             "  Median playtime at review - Positive: %.1fh, Negative: %.1fh",
+		"stats.still_playing":               "  Still playing (last 2 weeks): %d (%.1f%%) - Positive: %.1f%%",
+		"stats.not_playing":                 "  Not played in last 2 weeks: %d (%.1f%%) - Positive: %.1f%%",
+		"stats.dev_response_title":          "Developer Responses:",
+		"stats.dev_response_rate":           "  Overall: %d / %d (%.1f%%)",
+		"stats.dev_response_positive":       "  Positive reviews: %d / %d (%.1f%%)",
+		"stats.dev_response_negative":       "  Negative reviews: %d / %d (%.1f%%)",
+		"stats.dev_response_language":       "  %s: %d / %d (%.1f%%)",
+		"stats.dev_response_latency":        "  Median response time: %.1fh",
+		"stats.dev_response_latency_bucket": "    %s: %d",
+		"stats.dev_response_unanswered":     "  Unanswered negative reviews: %d",
+		"stats.helpfulness_title":           "Helpfulness-Weighted Statistics:",
+		"stats.vote_weighted":               "  Positive ratio weighted by helpful votes: %.1f%%",
+		"stats.score_weighted":              "  Positive ratio weighted by weighted_vote_score: %.1f%%",
+		"stats.wilson_overall":              "  Positive ratio 95%% confidence interval (Wilson): %.1f%% - %.1f%%",
+		"stats.wilson_language":             "    %s (%d reviews): %.1f%% - %.1f%%",
+		"stats.top_positive":                "  Most helpful positive reviews:",
+		"stats.top_negative":                "  Most helpful negative reviews:",
+		"stats.helpful_item":                "    [%s] %d helpful votes (%s) %s",
+		"stats.source_title":                "Early Access and Purchase Source:",
+		"stats.source_early_access":         "  Early Access vs after release:",
+		"stats.source_purchase":             "  Steam purchase vs key activation / other:",
+		"stats.source_free_copy":            "  Received for free vs paid:",
+		"stats.source_ea_label":             "Early Access",
+		"stats.source_released_label":       "After release",
+		"stats.source_steam_label":          "Steam purchase",
+		"stats.source_other_label":          "Key / other",
+		"stats.source_free_label":           "Received for free",
+		"stats.source_paid_label":           "Paid",
+		"stats.source_group":                "    %s: %d reviews - Positive: %d (%.1f%%)",
+		"stats.source_sentiment":            "      Mean sentiment: %.3f (%d scored)",
+		"stats.source_difference":           "    Difference: %+.1fpt (z=%.2f, p=%.3f) - %s",
+		"stats.significance_strong":         "significant (p<0.01)",
+		"stats.significance_weak":           "significant (p<0.05)",
+		"stats.significance_none":           "no significant difference",
+		"stats.significance_insufficient":   "too few reviews to judge (need %d per group)",
+		"stats.reviewer_title":              "Reviewer Profiles:",
+		"stats.reviewer_new_account":        "New accounts (<=%d games, <=%d reviews)",
+		"stats.reviewer_prolific":           "Prolific reviewers (>=%d reviews)",
+		"stats.reviewer_collector":          "Large libraries (>=%d games)",
+		"stats.reviewer_regular":            "Other reviewers",
+		"stats.reviewer_segment":            "  %s: %d (%.1f%%) - Positive: %.1f%%, median playtime at review: %.1fh",
+		"stats.suspicious_title":            "  Suspicious clusters (playtime <=%d min, <=%d games, %d+ reviews within %dh):",
+		"stats.suspicious_none":             "    None found",
+		"stats.suspicious_cluster":          "    %s - %s UTC: %d of %d reviews in window - Positive: %.1f%%",
+		"stats.length_title":                "Review Length and Shape:",
+		"stats.length_runes":                "  Characters - mean: %.1f, median: %.1f",
+		"stats.length_words":                "  Words (CJK: 1 per character) - mean: %.1f, median: %.1f",
+		"stats.length_by_vote":              "  Mean characters - Positive: %.1f, Negative: %.1f",
+		"stats.length_bucket":               "    %s characters: %d reviews - Positive: %d (%.1f%%)",
+		"stats.length_one_line":             "  One-line reviews: %d (%.1f%%)",
+		"stats.length_url":                  "  Reviews containing URLs: %d (%.1f%%)",
+		"stats.length_ascii_art":            "  Reviews containing ASCII art: %d (%.1f%%)",
+		"chart.timeline":                    "Reviews over time (per %s):",
+		"chart.timeline_range":              "  %s - %s (max %d)",
+		"chart.language_share":              "Language share:",
+		"chart.positive_ratio":              "Positive ratio by language:",
+		"chart.playtime":                    "Reviews by playtime at review:",
+		"compare.title":                     "=== Game comparison ===",
+		"compare.game":                      "Game",
+		"compare.app_id":                    "App ID",
+		"compare.total_reviews":             "Reviews",
+		"compare.positive_ratio":            "Positive",
+		"compare.languages":                 "Languages",
+		"compare.median_playtime":           "Median playtime at review",
+		"compare.early_access":              "Early Access reviews",
+		"compare.dev_response":              "Developer response rate",
+		"compare.steam_score":               "Steam rating",
+		"compare.steam_score_value":         "%s (%d reviews)",
+		"compare.loading":                   "Loading %s...",
+		"compare.csv_saved":                 "Comparison saved to %s",
+		"watch.started":                     "Watching %d games for new reviews every %s (press Ctrl+C to stop)",
+		"watch.loaded":                      "[%s] Loaded %d saved reviews from %s",
+		"watch.new_reviews":                 "[%s] %d new reviews (total %d) saved to %s",
+		"watch.no_new_reviews":              "[%s] No new reviews",
+		"watch.stopping":                    "Stopping after the current check finishes...",
+		"notify.title_new":                  "%s: %d new reviews",
+		"notify.title_negative":             "%s: %d new negative reviews (threshold: %d)",
+		"notify.title_keywords":             "%s: %d new reviews mentioning %s",
+		"notify.title_batch":                "%s (%d/%d)",
+		"notify.sent":                       "[%s] Sent %d notifications",
+		"notify.retry":                      "Webhook delivery failed, retrying in %v: %v",
+		"notify.no_baseline":                "No previously saved reviews in %s; notifications start with the next run",
+		"progress.status":                   "%d/%d reviews (%d%%), %d pages, %.1f reviews/s, ETA %s",
+		"progress.unknown":                  "%d reviews, %d pages, %.1f reviews/s",
+		"fetch.interrupted":                 "Interrupted before any reviews were fetched",
+		"fetch.interrupted_saved":           "Interrupted: saved the %d reviews fetched so far as incomplete to %s",
+		"fetch.failed_saved":                "%v (saved the %d reviews fetched so far as incomplete to %s)",
+		"fetch.reviews_fetched":             "Fetched %d reviews",
+		"dryrun.header":                     "Dry run: only the first page of each game is requested; no reviews are saved and no notifications are sent.",
+		"dryrun.game_name":                  "%s (App ID %s)",
+		"dryrun.game_appid":                 "App ID %s",
+		"dryrun.request":                    "  First page request: %s",
+		"dryrun.total":                      "  Reviews available (query_summary.total_reviews): %d",
+		"dryrun.reviews":                    "  Reviews to fetch: %d",
+		"dryrun.reviews_max":                "  Reviews to fetch: %d (limited by -max)",
+		"dryrun.pages":                      "  Requests: %d (%d reviews per page)",
+		"dryrun.duration":                   "  Estimated time: %s (%s per request measured on the first page, %s wait between requests)",
+		"dryrun.files":                      "  Files that would be written:",
+		"watch.stopped":                     "Watch stopped",
+		"export.saved":                      "%d reviews exported to %s",
+		"export.written":                    "Wrote %d reviews to standard output",
+		"stats.stdin_name":                  "standard input",
+		"config.file":                       "Config file: %s",
+		"config.profile":                    "Profile: %s",
+		"config.none":                       "(none)",
+		"config.key":                        "Key",
+		"config.value":                      "Value",
+		"config.source":                     "Source",
+		"stats.sentiment_title":             "Review Text Sentiment:",
+		"stats.sentiment_scored":            "  Scored reviews: %d (%.1f%%)",
+		"stats.sentiment_mean":              "  Mean score: %.3f (Positive reviews: %.3f, Negative reviews: %.3f)",
+		"stats.sentiment_bucket":            "    %s: %d (%.1f%%)",
+		"stats.sentiment_mismatched":        "  Reviews whose text disagrees with the recommendation: %d",
+		"stats.sentiment_mismatch_item":     "    [%s] voted_up=%t score=%.3f %s",
+		"stats.keywords_positive_terms":     "Terms Typical of Positive Reviews:",
+		"stats.keywords_negative_terms":     "Terms Typical of Negative Reviews:",
+		"stats.keywords_positive_bigrams":   "Bigrams Typical of Positive Reviews:",
+		"stats.keywords_negative_bigrams":   "Bigrams Typical of Negative Reviews:",
+		"stats.keywords_header":             "  Rank Term                     Positive Negative    Score",
+		"stats.keywords_none":               "  (not enough data)",
 
 		// File output
 		"file.saved_files":         "=== Saved Files ===",
@@ -98,12 +559,18 @@ Notes:
 		"file.free":                "Free: %t",
 		"file.retrieved_at":        "Retrieved At: %s",
 		"file.reviews_list":        "=== Reviews List ===",
+		"file.incomplete":          "*** INCOMPLETE: fetching did not complete; this file contains only the %d reviews collected by %s ***",
 		"file.review_number":       "=== Review %d ===",
 		"file.json_write_error":    "JSON write error: %w",
+		"file.csv_write_error":     "CSV write error: %w",
+		"file.write_error":         "File write error: %w",
 		"file.language_save_error": "Language %s file save error: %v",
 		"file.language_saved":      "Language %s: %d reviews saved to %s",
 		"file.all_languages_saved": "All languages summary file saved: %s (%d reviews)",
 		"file.summary_error":       "Summary file save error: %w",
+		"file.unanswered_saved":    "Unanswered negative reviews saved: %s (%d reviews)",
+		"file.read_error":          "File read error: %w",
+		"file.json_read_error":     "JSON read error: %w",
 
 		// API related error messages
 		"error.steam_api_fetch":    "Steam API fetch error: %w",
@@ -120,17 +587,23 @@ Notes:
 		// Verbose/Progress log messages
 		"verbose.review_fetch_start":    "Starting to fetch reviews for App ID %s",
 		"verbose.review_progress":       "Current reviews: %d, cursor: %s",
+		"verbose.page_fetched":          "Fetched page %d (%d reviews)",
 		"verbose.no_more_reviews":       "No more reviews available",
 		"verbose.max_reviews_reached":   "Reached maximum review count %d",
 		"verbose.cursor_not_changed":    "Cursor did not change. Ending process",
+		"verbose.known_review_reached":  "Reached already saved review %s. Ending process",
 		"verbose.total_reviews_fetched": "Fetched a total of %d reviews",
 		"verbose.game_review_fetch":     "Fetching reviews for game '%s' (App ID: %s)",
+		"verbose.app_id_lookup":         "Looking up the App ID of '%s'...",
 		"verbose.game_details_fetch":    "Fetching game details for App ID %s...",
 		"verbose.game_details_obtained": "Game details obtained: %s",
 
 		// Verbose logging
-		"verbose.review_saved":   "Reviews saved to %s",
-		"verbose.language_saved": "Language %s: %d reviews saved to %s",
+		"verbose.review_saved":     "Reviews saved to %s",
+		"verbose.language_saved":   "Language %s: %d reviews saved to %s",
+		"verbose.sentiment_scored": "Sentiment scores computed for %d of %d reviews",
+		"verbose.stats_saved":      "Statistics saved to %s",
+		"verbose.reviews_loaded":   "%d reviews loaded from %d files",
 
 		// Data fields (for output files)
 		"field.developer":    "Developer",
@@ -20,6 +20,19 @@ func Init() {
 	}
 }
 
+// SetLanguage 表示言語を変更（"ja_JP" のような形式も可。未対応の言語の場合はfalseを返し変更しない）
+func SetLanguage(lang string) bool {
+	normalized := normalizeLanguage(lang)
+	if !isSupportedLanguage(normalized) {
+		return false
+	}
+	globalLocalizer = &Localizer{
+		language: normalized,
+		messages: getMessages(normalized),
+	}
+	return true
+}
+
 // GetCurrentLanguage 現在の言語コードを取得
 func GetCurrentLanguage() string {
 	if globalLocalizer == nil {
@@ -14,71 +14,532 @@ func getJapaneseMessages() map[string]string {
 		"usage.full_text": `%s version %s
 
 使用方法:
+  steam-review <コマンド> [オプション] [引数]
+  steam-review [fetchのオプション]     ("steam-review fetch [オプション]" と同じ)
+
+各コマンドのオプションは "steam-review help <コマンド>" または "steam-review <コマンド> -help" で表示できます。
+`,
+		"usage.commands": "コマンド:",
+		"usage.global_options": `共通オプション (すべてのコマンドで使用可能):
+  -verbose            詳細なログを表示
+  -quiet              エラー以外の進捗・ログメッセージを表示しない (統計などの結果は表示する)
+  -log-level string   出力する最低のログレベル: debug, info, warn, error (デフォルト: info。-verbose は debug)
+  -log-format string  ログの形式: text, json (デフォルト: text)
+  -output string      出力ディレクトリ (デフォルト: output)
+  -locale string      表示言語: en, ja (デフォルト: STEAM_REVIEW_LANG, LANG などから判定)
+  -config string      設定ファイル (デフォルト: ./steam-review.toml, ユーザー設定ディレクトリの順に検索)
+  -profile string     使用する設定ファイルのプロファイル
+
+終了コード:
+  0  正常終了
+  1  その他の実行時のエラー (入力ファイルの読み込みなど)
+  2  コマンドや引数の指定の誤り
+  3  ゲームが見つからない
+  4  通信の失敗 (Steam API のリクエスト・レスポンス)
+  5  書き込みの失敗 (レビューファイル・統計・ディレクトリ)
+  6  一部のみ成功 (複数のゲームのうち一部が失敗し、残りは保存済み)
+  130  Ctrl+C (SIGINT)・SIGTERM による中断 (取得済みのレビューは不完全として保存)`,
+		"usage.environment": `環境変数 (設定ファイルより優先し、フラグより優先度が低い。
+値の形式はオプションと同じ):`,
+		"usage.hint": `使用方法は "steam-review help %s" で確認できます。`,
+
+		"command.fetch":      "レビューを取得してファイルに保存し、統計を表示",
+		"command.stats":      "-json で保存したレビューファイルの統計を表示",
+		"command.search":     "ゲーム名で検索してApp IDを表示",
+		"command.export":     "-json で保存したレビューファイルをテキスト・JSON・CSVに変換",
+		"command.details":    "ゲームのストア詳細情報を表示",
+		"command.compare":    "複数ゲームの統計を横並びで比較",
+		"command.watch":      "新しいレビューを定期的に取得して保存し続ける",
+		"command.config":     "設定ファイル・プロファイル・フラグを反映した設定を表示",
+		"command.completion": "シェルの補完スクリプトを出力",
+		"command.version":    "バージョン情報を表示",
+		"command.help":       "コマンドのヘルプを表示",
+
+		"usage.fetch": `%s version %s
+
+使用方法:
+  steam-review fetch [オプション]
+  steam-review fetch [オプション] -     (標準入力から1行に1つのApp IDまたはゲーム名を読み込む)
   steam-review [オプション]
 
 オプション:
   -appid string         Steam App ID (例: 440)
   -game string          ゲーム名 (例: "Team Fortress 2")
+  -games string         取得するゲームの一覧。-appid, -game がない場合に使用 (App IDまたはゲーム名, カンマ区切り)
   -max int             最大取得レビュー数 (デフォルト: 100, 0で無制限)
-  -lang string         取得する言語 (カンマ区切り, デフォルト: japanese, 例: "japanese,english")
-  -output string       出力ディレクトリ (デフォルト: output)
+  -lang string         取得する言語 (Steamの言語コードまたはISO/BCP 47 の言語タグをカンマ区切り, デフォルト: japanese, 例: "japanese,english" や "ja,zh-TW,pt-BR")
   -split              言語別にファイルを分けて保存
   -json               出力ファイルをJSON形式(.json)にする (デフォルト: テキスト形式)
-  -verbose            詳細なログを表示
   -filter string      レビューのフィルター (recent: 作成日時順, updated: 更新日時順, all: 有用性順(デフォルト))
-  -help               このヘルプを表示
-  -version            バージョン情報を表示
+  -playtime-buckets string  統計で使用するプレイ時間区分の境界 (時間単位, カンマ区切り, デフォルト: "1,5,20,100")
+  -unanswered         開発者が未返信の否定的レビューを有用性順で別ファイルに保存
+  -dry-run            最初のページのみリクエストし、取得計画 (リクエストURL、見込みの件数・時間、書き込むファイル) を表示して終了 (何も保存しない)
+  -summary-file string  実行結果の要約 (状態、終了コード、件数、保存したファイル、所要時間、エラー) をJSONで書き出すファイル
+  -keywords           肯定的・否定的レビューに特徴的な語とバイグラムを分析 (CSVにも保存)
+  -keywords-top int   キーワード分析で表示する上位語数 (デフォルト: 20)
+  -stopwords string   キーワード分析で追加除外するストップワードのファイル (1行1語)
+  -sentiment          同梱の感情辞書で本文の感情スコアを計算し、出力と統計に含める
+  -lexicon-dir string 感情辞書のディレクトリ (<Steam言語コード>.tsv で同梱の辞書を置き換え)
+  -top-helpful int    統計に表示する最も有用な肯定的・否定的レビューの件数 (デフォルト: 3)
+  -stats-format string  統計の出力形式: text, json, csv (デフォルト: text)
+                        json, csv で標準出力に書き出す場合はログを標準エラー出力に表示し、複数のゲームは JSON Lines / 1つのCSVで出力
+  -stats-file string    統計を標準出力ではなく指定したファイルに書き込む (複数のゲームではゲームごとに stats_440.json のように書き込む)
+  -charts             統計にチャートを表示 (標準出力が端末の場合のみ)
+  -chart-style string チャートの文字セット: unicode, ascii (デフォルト: unicode)
+
+通知オプション:
+  -webhook string          新しいレビューをPOSTするWebhook URL (前回保存したJSONファイルにないレビュー。-json が必要で -split とは併用不可)
+  -webhook-format string   本文の形式: json, slack, discord (デフォルト: json)
+  -webhook-template string 本文のGo text/template のファイル
+  -webhook-batch int       1回の通知に含める最大レビュー数 (デフォルト: 10)
+  -webhook-retries int     通信エラー・429・5xxの場合に待ち時間を倍にしながら再試行する回数 (デフォルト: 3)
+  -notify-on string        通知する条件: new, negative, keywords (カンマ区切り, デフォルト: new)
+  -notify-negative int     新しい否定的レビューがこの件数以上で通知 (デフォルト: 5)
+  -notify-keywords string  本文にこれらのキーワードを含む新しいレビューを通知 (カンマ区切り)
 
 使用例:
   # App IDを指定して日本語レビューを取得（デフォルト: 有用性順）
-  steam-review -appid 440 -max 500 -verbose
+  steam-review fetch -appid 440 -max 500 -verbose
 
   # 作成日時順でレビューを取得
-  steam-review -appid 440 -max 500 -filter recent -verbose
+  steam-review fetch -appid 440 -max 500 -filter recent -verbose
 
   # ゲーム名で英語レビューを取得
-  steam-review -game "Cyberpunk 2077" -lang "english" -max 1000 -output ./reviews
+  steam-review fetch -game "Cyberpunk 2077" -lang "english" -max 1000 -output ./reviews
 
   # 複数言語のレビューを取得
-  steam-review -game "Elden Ring" -lang "japanese,english" -max 300 -split
+  steam-review fetch -game "Elden Ring" -lang "japanese,english" -max 300 -split
 
   # 日本語レビューをJSON形式で保存
-  steam-review -appid 570 -max 2000 -output ./dota2_reviews -json -verbose
+  steam-review fetch -appid 570 -max 2000 -output ./dota2_reviews -json -verbose
 
   # すべての言語のレビューを取得
-  steam-review -appid 730 -lang "all" -max 1000 -split
+  steam-review fetch -appid 730 -lang "all" -max 1000 -split
 
   # 最近更新されたレビューから取得
-  steam-review -appid 730 -filter updated -max 200
+  steam-review fetch -appid 730 -filter updated -max 200
+
+  # 大量に取得する前にリクエスト・見込みの時間・出力ファイルを確認
+  steam-review fetch -appid 730 -lang "all" -max 0 -split -dry-run
+
+  # ファイルに書いたゲームを取得 (空行と # で始まる行は無視)
+  cat games.txt | steam-review fetch -json -
+
+  # スケジューラーから実行し、機械的に読み取れる実行結果を残す
+  steam-review fetch -games 440,730 -json -quiet -summary-file output/summary.json
 
 注意:
-  - App IDとゲーム名のどちらか一方を指定してください
+  - App IDとゲーム名のどちらか一方を指定してください (または -games、プロファイル、標準入力 (-) でゲームの一覧を指定)
   - -lang を指定しない場合、デフォルトで日本語レビューのみを取得します
   - "all" を指定するとすべての言語のレビューを取得します
   - 大量のレビューを取得する場合は時間がかかります
   - Steam APIのレート制限により、リクエスト間に1秒の待機時間があります`,
 
+		"usage.stats": `%s version %s
+
+使用方法:
+  steam-review stats [オプション] <ファイル.json> [<ファイル.json>...]
+
+"fetch -json" で保存したレビューファイルから、Steamにアクセスせずに統計を計算します。
+- を指定すると標準入力からレビューを読み込みます (保存したファイル、JSON配列、1行1件のJSON Lines)。
+-stats-format json, csv で標準出力に書き出す場合、ログは標準エラー出力に表示します。
+複数のファイル (言語別に分割したファイルなど) に同じIDのレビューがある場合は1件として数えます。
+統計では早期アクセス中と正式リリース後、Steamでの購入とキー有効化、無料入手と有料のレビューを比較し、有意差の目安 (z検定、各グループ10件以上) を表示します。
+
+オプション:
+  -playtime-buckets string  プレイ時間区分の境界 (時間単位, カンマ区切り, デフォルト: "1,5,20,100")
+  -keywords           肯定的・否定的レビューに特徴的な語とバイグラムを分析
+  -keywords-top int   キーワード分析で表示する上位語数 (デフォルト: 20)
+  -stopwords string   キーワード分析で追加除外するストップワードのファイル (1行1語)
+  -sentiment          感情辞書で本文の感情スコアを計算 (保存済みのスコアを置き換え)
+  -lexicon-dir string 感情辞書のディレクトリ (<Steam言語コード>.tsv で同梱の辞書を置き換え)
+  -top-helpful int    表示する最も有用な肯定的・否定的レビューの件数 (デフォルト: 3)
+  -stats-format string  出力形式: text, json, csv (デフォルト: text)
+  -stats-file string    統計を標準出力ではなく指定したファイルに書き込む
+  -charts             チャートを表示 (標準出力が端末の場合のみ)
+  -chart-style string チャートの文字セット: unicode, ascii (デフォルト: unicode)
+
+使用例:
+  steam-review stats -charts output/steam_reviews_440.json
+  steam-review stats -stats-format csv -stats-file stats.csv output/steam_reviews_440_*.json
+  jq -c '.reviews[] | select(.voted_up | not)' output/steam_reviews_440.json | steam-review stats -stats-format json -`,
+
+		"usage.search": `%s version %s
+
+使用方法:
+  steam-review search [オプション] <ゲーム名>
+
+名前に指定した文字列を含むゲームを、完全一致・前方一致・部分一致の順に表示します。
+
+オプション:
+  -limit int          表示する最大件数 (デフォルト: 20, 0で無制限)
+  -json               結果をJSON形式で表示
+
+使用例:
+  steam-review search "elden ring"`,
+
+		"usage.export": `%s version %s
+
+使用方法:
+  steam-review export [オプション] <ファイル.json> [<ファイル.json>...]
+
+"fetch -json" で保存したレビューファイルを別の形式に変換します。複数のファイルは1つにまとめます。
+- を指定すると標準入力からレビューを読み込みます (保存したファイル、JSON配列、JSON Lines)。
+この場合、-file を指定しなければ結果を標準出力に書き出します。
+
+オプション:
+  -format string      出力形式: text, json, csv, jsonl (デフォルト: csv)
+  -file string        出力ファイル、- で標準出力 (デフォルト: <出力ディレクトリ>/<最初の入力ファイル名>.<拡張子>)
+
+使用例:
+  steam-review export output/steam_reviews_440.json
+  steam-review export -format text -file reviews.txt output/steam_reviews_440.json
+  steam-review export -format jsonl -file - output/steam_reviews_440.json | jq -r '.review'`,
+
+		"usage.details": `%s version %s
+
+使用方法:
+  steam-review details [オプション] <App ID | ゲーム名>
+
+オプション:
+  -json               詳細情報をJSON形式で表示
+
+使用例:
+  steam-review details 440
+  steam-review details -json "Team Fortress 2"`,
+
+		"usage.config": `%s version %s
+
+使用方法:
+  steam-review config show [オプション]
+
+各設定の実際の値と取得元 (default, file, profile, env, flag) を表示します。
+fetch と同じオプションを指定できます。
+
+設定ファイル:
+  ./steam-review.toml、<ユーザー設定ディレクトリ>/steam-review/config.toml
+  (Linuxでは $XDG_CONFIG_HOME または ~/.config) の順に検索するか、-config で指定します。
+  キーはオプション名から "-" を除いたものです。トップレベルのキーはすべてのコマンドのデフォルト値、
+  [profiles.<名前>] セクションは -profile で選択するプロファイルです。
+  優先度: フラグ > 環境変数 > プロファイル > 設定ファイルのデフォルト値 > 組み込みのデフォルト値
+
+  lang = ["japanese", "english"]
+  output = "reviews"
+
+  [profiles.jp-daily]
+  lang = "japanese"
+  filter = "recent"
+  max = 200
+  json = true
+  games = ["440", "Elden Ring"]
+
+使用例:
+  steam-review config show -profile jp-daily
+  steam-review fetch -profile jp-daily`,
+
+		"usage.version": `%s version %s
+
+使用方法:
+  steam-review version`,
+
+		"usage.help": `%s version %s
+
+使用方法:
+  steam-review help [<コマンド>]`,
+
+		"usage.compare": `%s version %s
+
+使用方法:
+  steam-review compare [オプション] <対象> <対象> [<対象>...]
+
+対象にはApp ID、ゲーム名、-json で保存したレビューファイルを指定できます。
+
+オプション:
+  -max int             ゲームごとの最大取得レビュー数 (デフォルト: 100, 0で無制限)
+  -lang string         取得する言語 (Steamの言語コードまたはISO/BCP 47 の言語タグをカンマ区切り, デフォルト: japanese)
+  -filter string       レビューのフィルター (recent, updated, all(デフォルト))
+  -csv string          比較結果をCSVファイルにも保存
+
+使用例:
+  # 2つのゲームをApp IDで比較
+  steam-review compare -lang all 440 730
+
+  # 保存済みのレビューファイルを比較してCSVに出力
+  steam-review compare -csv compare.csv output/steam_reviews_440.json output/steam_reviews_730.json
+
+注意:
+  - オプションは対象より前に指定してください
+  - Steamの評価は全言語の公式評価で、保存済みファイルでは表示されません`,
+		"usage.watch": `%s version %s
+
+使用方法:
+  steam-review watch -appids <appid,...> [オプション]
+
+一定の間隔で各ゲームの新しいレビューを作成日時の新しい順 (filter=recent) に確認し、
+未保存のレビューだけを output/steam_reviews_<appid>.json に追加します。中断されるまで実行を続けます。
+
+オプション:
+  -appids string       監視するゲームのApp ID (カンマ区切り, 必須)
+  -interval duration   確認する間隔 (デフォルト: 15m, 最小: 1m)
+  -max int             保存済みのレビューがない場合に初回の確認でゲームごとに取得する最大レビュー数 (デフォルト: 100, 0で無制限)
+  -lang string         取得する言語 (Steamの言語コードまたはISO/BCP 47 の言語タグをカンマ区切り, デフォルト: japanese)
+
+通知オプション:
+  -webhook string          新しいレビューをPOSTするWebhook URL (確認ごとに追加したレビュー)
+  -webhook-format string   本文の形式: json, slack, discord (デフォルト: json)
+  -webhook-template string 本文のGo text/template のファイル
+  -webhook-batch int       1回の通知に含める最大レビュー数 (デフォルト: 10)
+  -webhook-retries int     通信エラー・429・5xxの場合に待ち時間を倍にしながら再試行する回数 (デフォルト: 3)
+  -notify-on string        通知する条件: new, negative, keywords (カンマ区切り, デフォルト: new)
+  -notify-negative int     新しい否定的レビューがこの件数以上で通知 (デフォルト: 5)
+  -notify-keywords string  本文にこれらのキーワードを含む新しいレビューを通知 (カンマ区切り)
+
+使用例:
+  # 2つのゲームを15分ごとに確認
+  steam-review watch -appids 440,730
+
+  # すべての言語のレビューを1時間ごとに確認
+  steam-review watch -appids 440 -interval 1h -lang all
+
+  # 新しい否定的レビューをSlackに通知
+  steam-review watch -appids 440 -webhook https://hooks.slack.com/services/... -webhook-format slack -notify-on negative -notify-negative 3
+
+注意:
+  - fetch -json で保存済みのファイルがあれば引き継ぎ、レビューIDで重複を除きます
+  - SIGINT・SIGTERM (Ctrl+C) を受け取ると、処理中の確認とファイルの書き込みを終えてから終了します
+  - 確認に失敗した場合はログに記録し、次の間隔で再試行します`,
+
+		"usage.completion": `%s version %s
+
+使用方法:
+  steam-review completion bash|zsh|fish
+  steam-review completion apps
+
+指定したシェルの補完スクリプトを出力します。サブコマンド、すべてのフラグ、
+-filter, -format, -stats-format, -chart-style, -webhook-format, -notify-on, -locale,
+-lang (Steamの言語コード) の値、ファイル名・ディレクトリ名を補完します。
+
+"completion apps" はSteamのアプリ一覧を取得し、ゲーム名をユーザーキャッシュディレクトリに
+保存します。このファイルがある場合、-game, -games, search, details でゲーム名も補完します。
+一覧を更新するには再度実行してください。
+
+設定方法:
+  # bash (~/.bashrc)
+  source <(steam-review completion bash)
+
+  # zsh (~/.zshrc の compinit の後)
+  source <(steam-review completion zsh)
+
+  # fish
+  steam-review completion fish > ~/.config/fish/completions/steam-review.fish
+
+  # ゲーム名も補完する場合
+  steam-review completion apps`,
+
 		// エラーメッセージ
-		"error.no_input":           "エラー: App ID またはゲーム名を指定してください",
-		"error.both_inputs":        "エラー: App ID とゲーム名の両方を指定することはできません",
-		"error.dir_creation":       "出力ディレクトリの作成に失敗しました: %v",
-		"error.review_fetch":       "レビュー取得エラー: %v",
-		"error.file_save":          "ファイル保存エラー: %v",
-		"error.logger_init":        "ロガーの初期化に失敗しました: %v",
-		"error.game_details_fetch": "ゲーム詳細情報の取得に失敗しました: %v",
+		"error.no_input":                 "エラー: App ID またはゲーム名を指定してください",
+		"error.both_inputs":              "エラー: App ID とゲーム名の両方を指定することはできません",
+		"error.dir_creation":             "出力ディレクトリの作成に失敗しました: %v",
+		"error.review_fetch":             "レビュー取得エラー: %v",
+		"error.file_save":                "ファイル保存エラー: %v",
+		"error.logger_init":              "ロガーの初期化に失敗しました: %v",
+		"error.game_details_fetch":       "ゲーム詳細情報の取得に失敗しました: %v",
+		"error.playtime_buckets":         "プレイ時間区分 %q が不正です: 昇順の正の時間数を指定してください (例: \"1,5,20,100\")",
+		"error.stopwords_load":           "ストップワードファイルの読み込みに失敗しました: %v",
+		"error.sentiment_init":           "感情辞書の読み込みに失敗しました: %v",
+		"error.lexicon_line":             "辞書の %d 行目が不正です: %q",
+		"error.lexicon_load":             "%s: %v",
+		"error.lexicon_dir":              "-lexicon-dir %q に感情辞書 (*.tsv) がありません",
+		"error.stats_format":             "不明な統計の出力形式です: %q (text, json, csv のいずれかを指定してください)",
+		"error.stats_write":              "統計の書き込みに失敗しました: %v",
+		"error.chart_style":              "不明なチャートの文字セットです: %q (unicode または ascii を指定してください)",
+		"error.log_level":                "不明なログのレベルです: %q (debug, info, warn, error のいずれかを指定してください)",
+		"error.log_format":               "不明なログの形式です: %q (text または json を指定してください)",
+		"error.compare_targets":          "エラー: 比較するApp ID・ゲーム名・保存済みJSONファイルを2つ以上指定してください",
+		"error.compare_target":           "%s: %v",
+		"error.watch_no_appids":          "エラー: 監視するゲームのApp IDを -appids で指定してください",
+		"error.stdin_conflict":           "エラー: - (標準入力からゲームの一覧を読み込む) は -appid, -game と同時に指定できません",
+		"error.stdin_read":               "標準入力の読み込みに失敗しました: %v",
+		"error.completion_shell":         "エラー: シェル (bash, zsh, fish) または \"apps\" を指定してください",
+		"error.completion_shell_unknown": "エラー: 対応していないシェルです: %q (bash, zsh, fish)",
+		"error.completion_apps":          "補完用のアプリ一覧の保存に失敗しました: %v",
+		"completion.apps_saved":          "補完用に%d件のゲーム名を保存しました: %s",
+		"error.watch_poll":               "[%s] 新しいレビューの確認に失敗しました (次回の確認時に再試行します): %v",
+		"error.webhook_template":         "Webhookのテンプレートのエラー: %v",
+		"error.webhook_send":             "Webhookの送信エラー: %v",
+		"error.webhook_status":           "Webhookがエラーを返しました: %s",
+		"error.notify":                   "[%s] 通知の送信に失敗しました: %v",
+		"error.locale":                   "サポートされていない表示言語です: %q (使用可能: en, ja)",
+		"error.unknown_command":          "不明なコマンドです: %q",
+		"error.no_input_files":           "-json で保存したレビューファイルを1つ以上指定してください",
+		"error.no_search_query":          "検索するゲーム名を指定してください",
+		"error.export_format":            "サポートされていない出力形式です: %q (使用可能: text, json, csv, jsonl)",
+		"error.export_overwrite":         "入力ファイルは上書きできません: %s",
+		"error.config_find":              "設定ファイルが見つかりません: %v",
+		"error.config_parse":             "設定ファイル %s の読み込みに失敗しました: %v",
+		"error.config_line":              "%d行目: %v",
+		"error.config_syntax":            "構文が正しくありません: %q",
+		"error.config_section":           "サポートされていないセクションです: %q ([profiles.<名前>] を使用してください)",
+		"error.config_key":               "不明なキーです: %q",
+		"error.config_duplicate":         "%q が複数回定義されています",
+		"error.config_value":             "値が正しくありません: %q (引用符で囲んだ文字列、整数、true/false、配列のいずれかを使用してください)",
+		"error.config_profile":           "設定ファイルにプロファイル %q がありません (使用可能: %s)",
+		"error.config_no_file":           "プロファイル %q が指定されましたが、設定ファイルが見つかりません",
+		"error.config_apply":             "%[3]s の %[2]q の値 %[1]q が正しくありません: %[4]v",
+		"error.env_apply":                "環境変数 %[2]s の値 %[1]q が正しくありません: %[3]v",
+		"error.invalid_field":            "-%s の値 %q が正しくありません: %s",
+		"validation.appid":               "App IDは数字で指定してください (ゲーム名は -game で指定します)",
+		"validation.appids":              "数字のApp IDで指定してください",
+		"validation.both_inputs":         "-appid と同時には指定できません。どちらか一方を指定してください",
+		"validation.non_negative":        "0以上で指定してください",
+		"validation.positive":            "1以上で指定してください",
+		"validation.one_of":              "次のいずれかを指定してください: %s",
+		"validation.language":            "Steamの言語コードまたは言語タグではありません (例: japanese, ja, zh-TW, pt-BR, all)",
+		"validation.language_suggest":    "Steamの言語コードではありません。%q ではありませんか?",
+		"validation.interval":            "%s以上で指定してください",
+		"validation.url":                 "http:// または https:// のURLで指定してください",
+		"validation.notify_keywords":     "-notify-on に keywords を含める場合は指定してください",
+		"validation.webhook_json":        "fetch で新しいレビューを判定するには -json が必要です (前回保存したJSONファイルと比較します)",
+		"validation.webhook_split":       "fetch では -webhook と併用できません (前回保存した1つのJSONファイルと比較します)",
+		"error.config_command":           "config のサブコマンド (show) を指定してください",
+		"error.fetch_games":              "%d/%d件のゲームの取得に失敗しました",
+		"error.summary_write":            "実行結果の要約の書き込みに失敗しました: %v",
 
 		// 成功メッセージ
 		"success.completed":  "処理が完了しました",
 		"success.file_saved": "レビューを %s に保存しました",
 
 		// 統計情報
-		"stats.title":              "=== レビュー統計 ===",
-		"stats.game":               "ゲーム: %s",
-		"stats.total_reviews":      "総レビュー数: %d",
-		"stats.positive":           "肯定的: %d (%.1f%%)",
-		"stats.negative":           "否定的: %d (%.1f%%)",
-		"stats.language_breakdown": "言語別レビュー統計:",
-		"stats.no_reviews":         "レビューが見つかりませんでした",
+		"stats.title":                       "=== レビュー統計 ===",
+		"stats.game":                        "ゲーム: %s",
+		"stats.total_reviews":               "総レビュー数: %d",
+		"stats.positive":                    "肯定的: %d (%.1f%%)",
+		"stats.negative":                    "否定的: %d (%.1f%%)",
+		"stats.language_breakdown":          "言語別レビュー統計:",
+		"stats.no_reviews":                  "レビューが見つかりませんでした",
+		"stats.playtime_breakdown":          "レビュー時点のプレイ時間別統計:",
+		"stats.playtime_bucket":             "  %s: %d件 - 肯定的: %d件 (%.1f%%)",
+		"stats.playtime_median":             "  レビュー時点のプレイ時間中央値 - 肯定的: %.1f時間, 否定的: %.1f時間",
+		"stats.still_playing":               "  プレイ継続中（直近2週間）: %d件 (%.1f%%) - 肯定的: %.1f%%",
+		"stats.not_playing":                 "  直近2週間プレイなし: %d件 (%.1f%%) - 肯定的: %.1f%%",
+		"stats.dev_response_title":          "開発者の返信:",
+		"stats.dev_response_rate":           "  全体: %d / %d件 (%.1f%%)",
+		"stats.dev_response_positive":       "  肯定的レビュー: %d / %d件 (%.1f%%)",
+		"stats.dev_response_negative":       "  否定的レビュー: %d / %d件 (%.1f%%)",
+		"stats.dev_response_language":       "  %s: %d / %d件 (%.1f%%)",
+		"stats.dev_response_latency":        "  返信までの時間の中央値: %.1f時間",
+		"stats.dev_response_latency_bucket": "    %s: %d件",
+		"stats.dev_response_unanswered":     "  未返信の否定的レビュー: %d件",
+		"stats.helpfulness_title":           "有用性で重み付けした統計:",
+		"stats.vote_weighted":               "  「役に立った」票数で重み付けした肯定的割合: %.1f%%",
+		"stats.score_weighted":              "  weighted_vote_scoreで重み付けした肯定的割合: %.1f%%",
+		"stats.wilson_overall":              "  肯定的割合の95%%信頼区間 (Wilson): %.1f%% - %.1f%%",
+		"stats.wilson_language":             "    %s (%d件): %.1f%% - %.1f%%",
+		"stats.top_positive":                "  最も有用な肯定的レビュー:",
+		"stats.top_negative":                "  最も有用な否定的レビュー:",
+		"stats.helpful_item":                "    [%s] 役に立った: %d票 (%s) %s",
+		"stats.source_title":                "早期アクセス・入手経路別の統計:",
+		"stats.source_early_access":         "  早期アクセス中 vs 正式リリース後:",
+		"stats.source_purchase":             "  Steamで購入 vs キー有効化など:",
+		"stats.source_free_copy":            "  無料で入手 vs 有料:",
+		"stats.source_ea_label":             "早期アクセス中",
+		"stats.source_released_label":       "正式リリース後",
+		"stats.source_steam_label":          "Steamで購入",
+		"stats.source_other_label":          "キー有効化など",
+		"stats.source_free_label":           "無料で入手",
+		"stats.source_paid_label":           "有料",
+		"stats.source_group":                "    %s: %d件 - 肯定的: %d件 (%.1f%%)",
+		"stats.source_sentiment":            "      感情スコア平均: %.3f (%d件)",
+		"stats.source_difference":           "    差: %+.1fpt (z=%.2f, p=%.3f) - %s",
+		"stats.significance_strong":         "有意差あり (p<0.01)",
+		"stats.significance_weak":           "有意差あり (p<0.05)",
+		"stats.significance_none":           "有意差なし",
+		"stats.significance_insufficient":   "判定に必要なレビュー数が不足 (各グループ%d件以上)",
+		"stats.reviewer_title":              "投稿者プロフィール別の統計:",
+		"stats.reviewer_new_account":        "新規アカウント (所持%d本以下, レビュー%d件以下)",
+		"stats.reviewer_prolific":           "多数レビュー投稿者 (レビュー%d件以上)",
+		"stats.reviewer_collector":          "多数ゲーム所持者 (所持%d本以上)",
+		"stats.reviewer_regular":            "その他の投稿者",
+		"stats.reviewer_segment":            "  %s: %d件 (%.1f%%) - 肯定的: %.1f%%, レビュー時点プレイ時間中央値: %.1f時間",
+		"stats.suspicious_title":            "  不審なクラスタ (プレイ時間%d分以下・所持%d本以下のアカウントが%d件以上, %d時間以内):",
+		"stats.suspicious_none":             "    該当なし",
+		"stats.suspicious_cluster":          "    %s - %s UTC: 期間内%[4]d件中%[3]d件 - 肯定的: %[5].1f%%",
+		"stats.length_title":                "レビュー本文の長さと形状:",
+		"stats.length_runes":                "  文字数 - 平均: %.1f, 中央値: %.1f",
+		"stats.length_words":                "  単語数 (CJKは1文字1語) - 平均: %.1f, 中央値: %.1f",
+		"stats.length_by_vote":              "  平均文字数 - 肯定的: %.1f, 否定的: %.1f",
+		"stats.length_bucket":               "    %s文字: %d件 - 肯定的: %d件 (%.1f%%)",
+		"stats.length_one_line":             "  1行のみのレビュー: %d件 (%.1f%%)",
+		"stats.length_url":                  "  URLを含むレビュー: %d件 (%.1f%%)",
+		"stats.length_ascii_art":            "  アスキーアートを含むレビュー: %d件 (%.1f%%)",
+		"chart.timeline":                    "レビュー数の推移 (集計単位: %s):",
+		"chart.timeline_range":              "  %s 〜 %s (最大 %d件)",
+		"chart.language_share":              "言語別シェア:",
+		"chart.positive_ratio":              "言語別の肯定的割合:",
+		"chart.playtime":                    "レビュー時点のプレイ時間別レビュー数:",
+		"compare.title":                     "=== ゲーム比較 ===",
+		"compare.game":                      "ゲーム",
+		"compare.app_id":                    "App ID",
+		"compare.total_reviews":             "レビュー数",
+		"compare.positive_ratio":            "肯定的",
+		"compare.languages":                 "言語構成",
+		"compare.median_playtime":           "レビュー時点プレイ時間(中央値)",
+		"compare.early_access":              "早期アクセス中のレビュー",
+		"compare.dev_response":              "開発者返信率",
+		"compare.steam_score":               "Steamの評価",
+		"compare.steam_score_value":         "%s (%d件)",
+		"compare.loading":                   "%s を読み込み中...",
+		"compare.csv_saved":                 "比較結果を %s に保存しました",
+		"watch.started":                     "%d件のゲームの新しいレビューを %s ごとに確認します (Ctrl+C で終了)",
+		"watch.loaded":                      "[%s] %[3]s から保存済みのレビュー%[2]d件を読み込みました",
+		"watch.new_reviews":                 "[%s] 新しいレビュー%d件 (合計%d件) を %s に保存しました",
+		"watch.no_new_reviews":              "[%s] 新しいレビューはありません",
+		"watch.stopping":                    "処理中の確認が終わり次第終了します...",
+		"notify.title_new":                  "%s: 新しいレビュー%d件",
+		"notify.title_negative":             "%s: 新しい否定的レビュー%d件 (しきい値: %d件)",
+		"notify.title_keywords":             "%s: %[3]s を含む新しいレビュー%[2]d件",
+		"notify.title_batch":                "%s (%d/%d)",
+		"notify.sent":                       "[%s] 通知を%d件送信しました",
+		"notify.retry":                      "Webhookの送信に失敗しました。%v後に再試行します: %v",
+		"notify.no_baseline":                "%s に前回保存したレビューがないため、通知は次回の実行から行います",
+		"progress.status":                   "%d/%d件 (%d%%), %dページ, %.1f件/秒, 残り約%s",
+		"progress.unknown":                  "%d件, %dページ, %.1f件/秒",
+		"fetch.interrupted":                 "レビューを取得する前に中断されました",
+		"fetch.interrupted_saved":           "中断されました: それまでに取得したレビュー%d件を不完全なデータとして %s に保存しました",
+		"fetch.failed_saved":                "%v (それまでに取得した%d件のレビューを不完全として %s に保存しました)",
+		"fetch.reviews_fetched":             "取得したレビュー数: %d件",
+		"dryrun.header":                     "ドライラン: 各ゲームの最初のページのみリクエストします。レビューの保存と通知は行いません。",
+		"dryrun.game_name":                  "%s (App ID %s)",
+		"dryrun.game_appid":                 "App ID %s",
+		"dryrun.request":                    "  最初のページのリクエスト: %s",
+		"dryrun.total":                      "  取得可能なレビュー数 (query_summary.total_reviews): %d件",
+		"dryrun.reviews":                    "  取得するレビュー数: %d件",
+		"dryrun.reviews_max":                "  取得するレビュー数: %d件 (-max による上限)",
+		"dryrun.pages":                      "  リクエスト数: %d (1ページ%d件)",
+		"dryrun.duration":                   "  所要時間の見込み: %s (最初のページで計測した1リクエストあたり%s、リクエストの間隔%s)",
+		"dryrun.files":                      "  書き込まれるファイル:",
+		"watch.stopped":                     "監視を終了しました",
+		"export.saved":                      "%d件のレビューを %s に出力しました",
+		"export.written":                    "%d件のレビューを標準出力に書き出しました",
+		"stats.stdin_name":                  "標準入力",
+		"config.file":                       "設定ファイル: %s",
+		"config.profile":                    "プロファイル: %s",
+		"config.none":                       "(なし)",
+		"config.key":                        "キー",
+		"config.value":                      "値",
+		"config.source":                     "取得元",
+		"stats.sentiment_title":             "本文の感情スコア:",
+		"stats.sentiment_scored":            "  スコア算出済み: %d件 (%.1f%%)",
+		"stats.sentiment_mean":              "  平均スコア: %.3f (肯定的レビュー: %.3f, 否定的レビュー: %.3f)",
+		"stats.sentiment_bucket":            "    %s: %d件 (%.1f%%)",
+		"stats.sentiment_mismatched":        "  評価と本文の感情が食い違うレビュー: %d件",
+		"stats.sentiment_mismatch_item":     "    [%s] voted_up=%t スコア=%.3f %s",
+		"stats.keywords_positive_terms":     "肯定的レビューに特徴的な語:",
+		"stats.keywords_negative_terms":     "否定的レビューに特徴的な語:",
+		"stats.keywords_positive_bigrams":   "肯定的レビューに特徴的なバイグラム:",
+		"stats.keywords_negative_bigrams":   "否定的レビューに特徴的なバイグラム:",
+		"stats.keywords_header":             "  順位 語                           肯定     否定  スコア",
+		"stats.keywords_none":               "  (データ不足)",
 
 		// ファイル出力
 		"file.saved_files":         "=== 保存したファイル一覧 ===",
@@ -98,12 +559,18 @@ func getJapaneseMessages() map[string]string {
 		"file.free":                "無料: %t",
 		"file.retrieved_at":        "情報取得日時: %s",
 		"file.reviews_list":        "=== レビュー一覧 ===",
+		"file.incomplete":          "*** 不完全なファイル: 取得が完了しなかったため、%[2]s までに取得した%[1]d件のレビューのみを含みます ***",
 		"file.review_number":       "=== レビュー %d ===",
 		"file.json_write_error":    "JSON書き込みエラー: %w",
+		"file.csv_write_error":     "CSV書き込みエラー: %w",
+		"file.write_error":         "ファイル書き込みエラー: %w",
 		"file.language_save_error": "言語 %s のファイル保存エラー: %v",
 		"file.language_saved":      "言語 %s: %d件のレビューを %s に保存",
 		"file.all_languages_saved": "全言語統合ファイルを保存: %s (%d件)",
 		"file.summary_error":       "サマリーファイル保存エラー: %w",
+		"file.unanswered_saved":    "未返信の否定的レビューを保存しました: %s (%d件)",
+		"file.read_error":          "ファイル読み込みエラー: %w",
+		"file.json_read_error":     "JSON読み込みエラー: %w",
 
 		// API関連エラーメッセージ
 		"error.steam_api_fetch":    "Steam API取得エラー: %w",
@@ -120,17 +587,23 @@ func getJapaneseMessages() map[string]string {
 		// Verbose/Progress ログメッセージ
 		"verbose.review_fetch_start":    "App ID %s のレビュー取得を開始します",
 		"verbose.review_progress":       "現在のレビュー数: %d, カーソル: %s",
+		"verbose.page_fetched":          "%dページ目を取得しました (%d件)",
 		"verbose.no_more_reviews":       "これ以上レビューがありません",
 		"verbose.max_reviews_reached":   "最大レビュー数 %d に到達しました",
 		"verbose.cursor_not_changed":    "カーソルが変更されませんでした。終了します",
+		"verbose.known_review_reached":  "保存済みのレビュー %s に達したため終了します",
 		"verbose.total_reviews_fetched": "合計 %d 件のレビューを取得しました",
 		"verbose.game_review_fetch":     "ゲーム '%s' (App ID: %s) のレビューを取得します",
+		"verbose.app_id_lookup":         "ゲーム '%s' のApp IDを検索中...",
 		"verbose.game_details_fetch":    "App ID %s のゲーム詳細情報を取得中...",
 		"verbose.game_details_obtained": "ゲーム詳細情報を取得しました: %s",
 
 		// 詳細ログ
-		"verbose.review_saved":   "レビューを %s に保存しました",
-		"verbose.language_saved": "言語 %s: %d件のレビューを %s に保存",
+		"verbose.review_saved":     "レビューを %s に保存しました",
+		"verbose.language_saved":   "言語 %s: %d件のレビューを %s に保存",
+		"verbose.sentiment_scored": "%d / %d件のレビューの感情スコアを計算しました",
+		"verbose.stats_saved":      "統計を %s に保存しました",
+		"verbose.reviews_loaded":   "%d件のレビューを%d個のファイルから読み込みました",
 
 		// データフィールド（出力ファイル用）
 		"field.developer":    "開発者",
@@ -8,33 +8,247 @@ const (
 	MsgAppStarted = "app.started"
 
 	// 使用方法とヘルプ
-	MsgUsageTitle    = "usage.title"
-	MsgUsageOptions  = "usage.options"
-	MsgUsageExamples = "usage.examples"
-	MsgUsageHelp     = "usage.help_text"
-	MsgUsageFull     = "usage.full_text"
+	MsgUsageTitle      = "usage.title"
+	MsgUsageOptions    = "usage.options"
+	MsgUsageExamples   = "usage.examples"
+	MsgUsageHelp       = "usage.help_text"
+	MsgUsageFull       = "usage.full_text"
+	MsgUsageCompare    = "usage.compare"
+	MsgUsageWatch      = "usage.watch"
+	MsgUsageCompletion = "usage.completion"
+	MsgUsageFetch      = "usage.fetch"
+	MsgUsageStats      = "usage.stats"
+	MsgUsageSearch     = "usage.search"
+	MsgUsageExport     = "usage.export"
+	MsgUsageDetails    = "usage.details"
+	MsgUsageVersion    = "usage.version"
+	MsgUsageHelpCmd    = "usage.help"
+	MsgUsageConfig     = "usage.config"
+
+	MsgUsageCommands      = "usage.commands"
+	MsgUsageGlobalOptions = "usage.global_options"
+	MsgUsageHint          = "usage.hint"
+	MsgUsageEnvironment   = "usage.environment"
+
+	// サブコマンドの説明
+	MsgCommandFetch      = "command.fetch"
+	MsgCommandStats      = "command.stats"
+	MsgCommandSearch     = "command.search"
+	MsgCommandExport     = "command.export"
+	MsgCommandDetails    = "command.details"
+	MsgCommandCompare    = "command.compare"
+	MsgCommandWatch      = "command.watch"
+	MsgCommandConfig     = "command.config"
+	MsgCommandVersion    = "command.version"
+	MsgCommandHelp       = "command.help"
+	MsgCommandCompletion = "command.completion"
 
 	// エラーメッセージ
-	MsgErrorNoInput         = "error.no_input"
-	MsgErrorBothInputs      = "error.both_inputs"
-	MsgErrorDirCreation     = "error.dir_creation"
-	MsgErrorReviewFetch     = "error.review_fetch"
-	MsgErrorFileSave        = "error.file_save"
-	MsgErrorLoggerInit      = "error.logger_init"
-	MsgErrorGameDetailsInit = "error.game_details_fetch"
+	MsgErrorNoInput                = "error.no_input"
+	MsgErrorBothInputs             = "error.both_inputs"
+	MsgErrorDirCreation            = "error.dir_creation"
+	MsgErrorReviewFetch            = "error.review_fetch"
+	MsgErrorFileSave               = "error.file_save"
+	MsgErrorLoggerInit             = "error.logger_init"
+	MsgErrorGameDetailsInit        = "error.game_details_fetch"
+	MsgErrorPlaytimeBuckets        = "error.playtime_buckets"
+	MsgErrorStopwordsLoad          = "error.stopwords_load"
+	MsgErrorSentimentInit          = "error.sentiment_init"
+	MsgErrorLexiconLine            = "error.lexicon_line"
+	MsgErrorLexiconLoad            = "error.lexicon_load"
+	MsgErrorLexiconDir             = "error.lexicon_dir"
+	MsgErrorStatsFormat            = "error.stats_format"
+	MsgErrorStatsWrite             = "error.stats_write"
+	MsgErrorChartStyle             = "error.chart_style"
+	MsgErrorLogLevel               = "error.log_level"
+	MsgErrorLogFormat              = "error.log_format"
+	MsgErrorCompareTargets         = "error.compare_targets"
+	MsgErrorCompareTarget          = "error.compare_target"
+	MsgErrorWatchNoAppIDs          = "error.watch_no_appids"
+	MsgErrorStdinConflict          = "error.stdin_conflict"
+	MsgErrorStdinRead              = "error.stdin_read"
+	MsgErrorCompletionShell        = "error.completion_shell"
+	MsgErrorCompletionShellUnknown = "error.completion_shell_unknown"
+	MsgErrorCompletionApps         = "error.completion_apps"
+	MsgCompletionAppsSaved         = "completion.apps_saved"
+	MsgErrorWatchPoll              = "error.watch_poll"
+	MsgErrorWebhookTemplate        = "error.webhook_template"
+	MsgErrorWebhookSend            = "error.webhook_send"
+	MsgErrorWebhookStatus          = "error.webhook_status"
+	MsgErrorNotify                 = "error.notify"
+	MsgErrorLocale                 = "error.locale"
+	MsgErrorUnknownCommand         = "error.unknown_command"
+	MsgErrorNoInputFiles           = "error.no_input_files"
+	MsgErrorNoSearchQuery          = "error.no_search_query"
+	MsgErrorExportFormat           = "error.export_format"
+	MsgErrorExportOverwrite        = "error.export_overwrite"
+	MsgErrorConfigFind             = "error.config_find"
+	MsgErrorConfigParse            = "error.config_parse"
+	MsgErrorConfigLine             = "error.config_line"
+	MsgErrorConfigSyntax           = "error.config_syntax"
+	MsgErrorConfigSection          = "error.config_section"
+	MsgErrorConfigKey              = "error.config_key"
+	MsgErrorConfigDuplicate        = "error.config_duplicate"
+	MsgErrorConfigValue            = "error.config_value"
+	MsgErrorConfigProfile          = "error.config_profile"
+	MsgErrorConfigNoFile           = "error.config_no_file"
+	MsgErrorConfigApply            = "error.config_apply"
+	MsgErrorEnvApply               = "error.env_apply"
+	MsgErrorInvalidField           = "error.invalid_field"
+
+	// 設定値の検証
+	MsgValidationAppID           = "validation.appid"
+	MsgValidationAppIDs          = "validation.appids"
+	MsgValidationBothInputs      = "validation.both_inputs"
+	MsgValidationNonNegative     = "validation.non_negative"
+	MsgValidationPositive        = "validation.positive"
+	MsgValidationOneOf           = "validation.one_of"
+	MsgValidationLanguage        = "validation.language"
+	MsgValidationLanguageSuggest = "validation.language_suggest"
+	MsgValidationInterval        = "validation.interval"
+	MsgValidationURL             = "validation.url"
+	MsgValidationNotifyKeywords  = "validation.notify_keywords"
+	MsgValidationWebhookJSON     = "validation.webhook_json"
+	MsgValidationWebhookSplit    = "validation.webhook_split"
+	MsgErrorConfigCommand        = "error.config_command"
+	MsgErrorFetchGames           = "error.fetch_games"
+	MsgErrorSummaryWrite         = "error.summary_write"
 
 	// 成功メッセージ
 	MsgSuccessCompleted = "success.completed"
 	MsgSuccessFileSaved = "success.file_saved"
 
 	// 統計情報
-	MsgStatsTitle             = "stats.title"
-	MsgStatsGame              = "stats.game"
-	MsgStatsTotalReviews      = "stats.total_reviews"
-	MsgStatsPositive          = "stats.positive"
-	MsgStatsNegative          = "stats.negative"
-	MsgStatsLanguageBreakdown = "stats.language_breakdown"
-	MsgStatsNoReviews         = "stats.no_reviews"
+	MsgStatsTitle                    = "stats.title"
+	MsgStatsGame                     = "stats.game"
+	MsgStatsTotalReviews             = "stats.total_reviews"
+	MsgStatsPositive                 = "stats.positive"
+	MsgStatsNegative                 = "stats.negative"
+	MsgStatsLanguageBreakdown        = "stats.language_breakdown"
+	MsgStatsNoReviews                = "stats.no_reviews"
+	MsgStatsPlaytimeBreakdown        = "stats.playtime_breakdown"
+	MsgStatsPlaytimeBucket           = "stats.playtime_bucket"
+	MsgStatsPlaytimeMedian           = "stats.playtime_median"
+	MsgStatsStillPlaying             = "stats.still_playing"
+	MsgStatsNotPlaying               = "stats.not_playing"
+	MsgStatsDevResponseTitle         = "stats.dev_response_title"
+	MsgStatsDevResponseRate          = "stats.dev_response_rate"
+	MsgStatsDevResponsePositive      = "stats.dev_response_positive"
+	MsgStatsDevResponseNegative      = "stats.dev_response_negative"
+	MsgStatsDevResponseLanguage      = "stats.dev_response_language"
+	MsgStatsDevResponseLatency       = "stats.dev_response_latency"
+	MsgStatsDevResponseLatencyBucket = "stats.dev_response_latency_bucket"
+	MsgStatsDevResponseUnanswered    = "stats.dev_response_unanswered"
+	MsgStatsHelpfulnessTitle         = "stats.helpfulness_title"
+	MsgStatsVoteWeighted             = "stats.vote_weighted"
+	MsgStatsScoreWeighted            = "stats.score_weighted"
+	MsgStatsWilsonOverall            = "stats.wilson_overall"
+	MsgStatsWilsonLanguage           = "stats.wilson_language"
+	MsgStatsTopPositive              = "stats.top_positive"
+	MsgStatsTopNegative              = "stats.top_negative"
+	MsgStatsHelpfulItem              = "stats.helpful_item"
+	MsgStatsSourceTitle              = "stats.source_title"
+	MsgStatsSourceEarlyAccess        = "stats.source_early_access"
+	MsgStatsSourcePurchase           = "stats.source_purchase"
+	MsgStatsSourceFreeCopy           = "stats.source_free_copy"
+	MsgStatsSourceEALabel            = "stats.source_ea_label"
+	MsgStatsSourceReleasedLabel      = "stats.source_released_label"
+	MsgStatsSourceSteamLabel         = "stats.source_steam_label"
+	MsgStatsSourceOtherLabel         = "stats.source_other_label"
+	MsgStatsSourceFreeLabel          = "stats.source_free_label"
+	MsgStatsSourcePaidLabel          = "stats.source_paid_label"
+	MsgStatsSourceGroup              = "stats.source_group"
+	MsgStatsSourceSentiment          = "stats.source_sentiment"
+	MsgStatsSourceDifference         = "stats.source_difference"
+	MsgStatsSignificanceStrong       = "stats.significance_strong"
+	MsgStatsSignificanceWeak         = "stats.significance_weak"
+	MsgStatsSignificanceNone         = "stats.significance_none"
+	MsgStatsSignificanceInsufficient = "stats.significance_insufficient"
+	MsgStatsReviewerTitle            = "stats.reviewer_title"
+	MsgStatsReviewerNewAccount       = "stats.reviewer_new_account"
+	MsgStatsReviewerProlific         = "stats.reviewer_prolific"
+	MsgStatsReviewerCollector        = "stats.reviewer_collector"
+	MsgStatsReviewerRegular          = "stats.reviewer_regular"
+	MsgStatsReviewerSegment          = "stats.reviewer_segment"
+	MsgStatsSuspiciousTitle          = "stats.suspicious_title"
+	MsgStatsSuspiciousNone           = "stats.suspicious_none"
+	MsgStatsSuspiciousCluster        = "stats.suspicious_cluster"
+	MsgStatsLengthTitle              = "stats.length_title"
+	MsgStatsLengthRunes              = "stats.length_runes"
+	MsgStatsLengthWords              = "stats.length_words"
+	MsgStatsLengthByVote             = "stats.length_by_vote"
+	MsgStatsLengthBucket             = "stats.length_bucket"
+	MsgStatsLengthOneLine            = "stats.length_one_line"
+	MsgStatsLengthURL                = "stats.length_url"
+	MsgStatsLengthASCIIArt           = "stats.length_ascii_art"
+	MsgChartTimeline                 = "chart.timeline"
+	MsgChartTimelineRange            = "chart.timeline_range"
+	MsgChartLanguageShare            = "chart.language_share"
+	MsgChartPositiveRatio            = "chart.positive_ratio"
+	MsgChartPlaytime                 = "chart.playtime"
+	MsgCompareTitle                  = "compare.title"
+	MsgCompareGame                   = "compare.game"
+	MsgCompareAppID                  = "compare.app_id"
+	MsgCompareTotalReviews           = "compare.total_reviews"
+	MsgComparePositiveRatio          = "compare.positive_ratio"
+	MsgCompareLanguages              = "compare.languages"
+	MsgCompareMedianPlaytime         = "compare.median_playtime"
+	MsgCompareEarlyAccess            = "compare.early_access"
+	MsgCompareDevResponse            = "compare.dev_response"
+	MsgCompareSteamScore             = "compare.steam_score"
+	MsgCompareSteamScoreValue        = "compare.steam_score_value"
+	MsgCompareLoading                = "compare.loading"
+	MsgCompareCSVSaved               = "compare.csv_saved"
+	MsgWatchStarted                  = "watch.started"
+	MsgWatchLoaded                   = "watch.loaded"
+	MsgWatchNewReviews               = "watch.new_reviews"
+	MsgWatchNoNewReviews             = "watch.no_new_reviews"
+	MsgWatchStopping                 = "watch.stopping"
+	MsgNotifyTitleNew                = "notify.title_new"
+	MsgNotifyTitleNegative           = "notify.title_negative"
+	MsgNotifyTitleKeywords           = "notify.title_keywords"
+	MsgNotifyTitleBatch              = "notify.title_batch"
+	MsgNotifySent                    = "notify.sent"
+	MsgNotifyRetry                   = "notify.retry"
+	MsgNotifyNoBaseline              = "notify.no_baseline"
+	MsgProgressStatus                = "progress.status"
+	MsgProgressUnknown               = "progress.unknown"
+	MsgFetchInterrupted              = "fetch.interrupted"
+	MsgFetchInterruptedSaved         = "fetch.interrupted_saved"
+	MsgFetchFailedSaved              = "fetch.failed_saved"
+	MsgFetchReviewsFetched           = "fetch.reviews_fetched"
+	MsgDryRunHeader                  = "dryrun.header"
+	MsgDryRunGameName                = "dryrun.game_name"
+	MsgDryRunGameAppID               = "dryrun.game_appid"
+	MsgDryRunRequest                 = "dryrun.request"
+	MsgDryRunTotal                   = "dryrun.total"
+	MsgDryRunReviews                 = "dryrun.reviews"
+	MsgDryRunReviewsMax              = "dryrun.reviews_max"
+	MsgDryRunPages                   = "dryrun.pages"
+	MsgDryRunDuration                = "dryrun.duration"
+	MsgDryRunFiles                   = "dryrun.files"
+	MsgWatchStopped                  = "watch.stopped"
+	MsgExportSaved                   = "export.saved"
+	MsgExportWritten                 = "export.written"
+	MsgStdinName                     = "stats.stdin_name"
+	MsgConfigFile                    = "config.file"
+	MsgConfigProfile                 = "config.profile"
+	MsgConfigNone                    = "config.none"
+	MsgConfigKey                     = "config.key"
+	MsgConfigValue                   = "config.value"
+	MsgConfigSource                  = "config.source"
+	MsgStatsSentimentTitle           = "stats.sentiment_title"
+	MsgStatsSentimentScored          = "stats.sentiment_scored"
+	MsgStatsSentimentMean            = "stats.sentiment_mean"
+	MsgStatsSentimentBucket          = "stats.sentiment_bucket"
+	MsgStatsSentimentMismatched      = "stats.sentiment_mismatched"
+	MsgStatsSentimentMismatchItem    = "stats.sentiment_mismatch_item"
+	MsgStatsKeywordsPositiveTerms    = "stats.keywords_positive_terms"
+	MsgStatsKeywordsNegativeTerms    = "stats.keywords_negative_terms"
+	MsgStatsKeywordsPositiveBigrams  = "stats.keywords_positive_bigrams"
+	MsgStatsKeywordsNegativeBigrams  = "stats.keywords_negative_bigrams"
+	MsgStatsKeywordsHeader           = "stats.keywords_header"
+	MsgStatsKeywordsNone             = "stats.keywords_none"
 
 	// ファイル出力
 	MsgFileSavedFiles        = "file.saved_files"
@@ -54,16 +268,25 @@ const (
 	MsgFileFree              = "file.free"
 	MsgFileRetrievedAt       = "file.retrieved_at"
 	MsgFileReviewsList       = "file.reviews_list"
+	MsgFileIncomplete        = "file.incomplete"
 	MsgFileReviewNumber      = "file.review_number"
 	MsgFileJSONWriteError    = "file.json_write_error"
+	MsgFileCSVWriteError     = "file.csv_write_error"
+	MsgFileWriteError        = "file.write_error"
 	MsgFileLanguageSaveError = "file.language_save_error"
 	MsgFileLanguageSaved     = "file.language_saved"
 	MsgFileAllLanguagesSaved = "file.all_languages_saved"
 	MsgFileSummaryError      = "file.summary_error"
+	MsgFileUnansweredSaved   = "file.unanswered_saved"
+	MsgFileReadError         = "file.read_error"
+	MsgFileJSONReadError     = "file.json_read_error"
 
 	// 詳細ログ
-	MsgVerboseReviewSaved   = "verbose.review_saved"
-	MsgVerboseLanguageSaved = "verbose.language_saved"
+	MsgVerboseReviewSaved     = "verbose.review_saved"
+	MsgVerboseLanguageSaved   = "verbose.language_saved"
+	MsgVerboseSentimentScored = "verbose.sentiment_scored"
+	MsgVerboseStatsSaved      = "verbose.stats_saved"
+	MsgVerboseReviewsLoaded   = "verbose.reviews_loaded"
 
 	// API関連エラーメッセージ
 	MsgErrorSteamAPIFetch    = "error.steam_api_fetch"
@@ -80,11 +303,14 @@ const (
 	// Verbose/Progress ログメッセージ
 	MsgVerboseReviewFetchStart    = "verbose.review_fetch_start"
 	MsgVerboseReviewProgress      = "verbose.review_progress"
+	MsgVerbosePageFetched         = "verbose.page_fetched"
 	MsgVerboseNoMoreReviews       = "verbose.no_more_reviews"
 	MsgVerboseMaxReviewsReached   = "verbose.max_reviews_reached"
 	MsgVerboseCursorNotChanged    = "verbose.cursor_not_changed"
+	MsgVerboseKnownReviewReached  = "verbose.known_review_reached"
 	MsgVerboseTotalReviewsFetched = "verbose.total_reviews_fetched"
 	MsgVerboseGameReviewFetch     = "verbose.game_review_fetch"
+	MsgVerboseAppIDLookup         = "verbose.app_id_lookup"
 	MsgVerboseGameDetailsFetch    = "verbose.game_details_fetch"
 	MsgVerboseGameDetailsObtained = "verbose.game_details_obtained"
 
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/y-moriya/steam-review/internal/api"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// runSearch search コマンド: 名前からゲームを検索してApp IDを表示
+func runSearch(ctx context.Context, args []string) error {
+	var cfg config.Config
+	var limit int
+	var outputJSON bool
+
+	fs := newFlagSet("search", &cfg)
+	fs.IntVar(&limit, "limit", 20, "表示する最大件数 (0で無制限)")
+	fs.BoolVar(&outputJSON, "json", false, "結果をJSON形式で表示")
+	if err := parseFlags(fs, args, &cfg); err != nil {
+		return err
+	}
+
+	query := strings.Join(fs.Args(), " ")
+	if strings.TrimSpace(query) == "" {
+		return newUsageError(i18n.T(i18n.MsgErrorNoSearchQuery))
+	}
+
+	apps, err := api.SearchAppsContext(ctx, query, limit)
+	if err != nil {
+		return newFailure(exitNetwork, err)
+	}
+	if len(apps) == 0 {
+		return newFailure(exitNotFound, errors.New(i18n.Tf(i18n.MsgErrorGameNotFound, query)))
+	}
+
+	if outputJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(apps)
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "%s\t%s\n", i18n.T(i18n.MsgCompareAppID), i18n.T(i18n.MsgCompareGame))
+	for _, app := range apps {
+		fmt.Fprintf(tw, "%s\t%s\n", app.AppID, app.Name)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/internal/stats"
+	"github.com/y-moriya/steam-review/internal/storage"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// loadReviewFiles 保存済みのJSONファイルを読み込み、レビューを結合する
+//
+// ファイル名が stdinArg の場合は stdin から読み込む（JSON Lines 形式にも対応）。
+// 言語別に分割したファイルを指定した場合などに備え、同じIDのレビューは1件にまとめる。
+// ゲーム詳細情報は最初に見つかったものを返す。
+func loadReviewFiles(filenames []string, stdin io.Reader) ([]models.ReviewData, *models.GameDetails, error) {
+	var reviews []models.ReviewData
+	var gameDetails *models.GameDetails
+	seen := make(map[string]bool)
+
+	for _, filename := range filenames {
+		var loaded []models.ReviewData
+		var details *models.GameDetails
+		var err error
+		if filename == stdinArg {
+			loaded, details, err = storage.LoadReviews(stdin)
+		} else {
+			loaded, details, err = storage.LoadReviewsFromFile(filename)
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if gameDetails == nil {
+			gameDetails = details
+		}
+		for _, review := range loaded {
+			if review.RecommendationID != "" {
+				if seen[review.RecommendationID] {
+					continue
+				}
+				seen[review.RecommendationID] = true
+			}
+			reviews = append(reviews, review)
+		}
+	}
+	return reviews, gameDetails, nil
+}
+
+// runStats stats コマンド: 保存済みのJSONファイルから統計を表示
+func runStats(_ context.Context, args []string) error {
+	var cfg config.Config
+	var sf statsFlags
+
+	fs := newFlagSet("stats", &cfg)
+	registerStatsFlags(fs, &cfg, &sf)
+	if err := parseFlags(fs, args, &cfg); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		return newUsageError(i18n.T(i18n.MsgErrorNoInputFiles))
+	}
+	if err := cfg.Validate(); err != nil {
+		return usageError{err: err}
+	}
+
+	statsOpts, analyzer, err := statsOptions(&cfg, sf)
+	if err != nil {
+		return err
+	}
+
+	log, err := newLogger(cfg)
+	if err != nil {
+		return err
+	}
+	defer log.Close()
+	// 統計をJSON・CSVで標準出力に書き出す場合は、ログが混ざらないように標準エラー出力に表示する
+	if cfg.StatsFile == "" && cfg.StatsFormat != config.StatsFormatText {
+		log.LogToStderr()
+	}
+
+	reviews, gameDetails, err := loadReviewFiles(fs.Args(), os.Stdin)
+	if err != nil {
+		return logError(log, err)
+	}
+	log.Debug(i18n.Tf(i18n.MsgVerboseReviewsLoaded, len(reviews), len(fs.Args())))
+
+	if analyzer != nil {
+		annotateSentiment(reviews, analyzer, log)
+	}
+
+	gameName := strings.TrimSuffix(filepath.Base(fs.Arg(0)), filepath.Ext(fs.Arg(0)))
+	if fs.Arg(0) == stdinArg {
+		gameName = i18n.T(i18n.MsgStdinName)
+	}
+	if gameDetails != nil {
+		gameName = gameDetails.Name
+	}
+
+	if err := writeStats(stats.Compute(reviews, gameName, statsOpts), cfg, log); err != nil {
+		return logError(log, newFailure(exitWrite, errors.New(i18n.Tf(i18n.MsgErrorStatsWrite, err))))
+	}
+	return nil
+}
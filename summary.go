@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// 実行結果の要約の status
+const (
+	summaryStatusOK          = "ok"          // すべてのゲームを取得・保存した
+	summaryStatusPartial     = "partial"     // 一部のゲームの取得・保存に失敗した
+	summaryStatusFailed      = "failed"      // 失敗した
+	summaryStatusInterrupted = "interrupted" // 中断された
+)
+
+// gameResult 実行結果の要約に記録する1つのゲームの取得結果
+type gameResult struct {
+	Target     string   `json:"target"`               // 指定された App ID またはゲーム名
+	AppID      string   `json:"app_id,omitempty"`     // 取得したゲームの App ID
+	Name       string   `json:"name,omitempty"`       // ストアのゲーム名（取得できた場合）
+	Reviews    int      `json:"reviews"`              // 取得したレビュー数
+	Incomplete bool     `json:"incomplete,omitempty"` // 中断により途中までのレビューを保存した
+	Files      []string `json:"files"`                // 保存したファイル
+	ExitCode   int      `json:"exit_code"`            // このゲームだけを取得した場合の終了コード
+	Error      string   `json:"error,omitempty"`
+}
+
+// summaryCounts 実行結果の要約のゲーム数
+type summaryCounts struct {
+	Total     int `json:"total"`
+	Succeeded int `json:"succeeded"`
+	Failed    int `json:"failed"`
+}
+
+// runSummary -summary-file に書き出す実行結果の要約（スケジューラーなどから機械的に読み取るためのもの）
+type runSummary struct {
+	Command         string        `json:"command"`
+	Version         string        `json:"version"`
+	Status          string        `json:"status"` // ok, partial, failed, interrupted
+	ExitCode        int           `json:"exit_code"`
+	StartedAt       time.Time     `json:"started_at"`
+	FinishedAt      time.Time     `json:"finished_at"`
+	DurationSeconds float64       `json:"duration_seconds"`
+	Games           summaryCounts `json:"games"`
+	Reviews         int           `json:"reviews"` // 取得したレビュー数の合計
+	Files           []string      `json:"files"`   // 保存したファイルの一覧
+	Errors          []string      `json:"errors"`
+	Results         []gameResult  `json:"results"`
+}
+
+// newRunSummary 実行結果の要約を作成
+func newRunSummary(command string, startedAt time.Time) *runSummary {
+	return &runSummary{Command: command, Version: config.Version, StartedAt: startedAt, Files: []string{}, Errors: []string{}, Results: []gameResult{}}
+}
+
+// add ゲームの取得結果を追加（err はそのゲームの取得・保存のエラー）
+func (s *runSummary) add(result gameResult, err error) {
+	if result.Files == nil {
+		result.Files = []string{}
+	}
+	result.ExitCode = errorExitCode(err)
+	if err != nil {
+		result.Error = err.Error()
+	}
+	s.Results = append(s.Results, result)
+}
+
+// finish コマンドの実行結果 err から終了コード・件数などを集計
+func (s *runSummary) finish(err error, finishedAt time.Time) {
+	s.FinishedAt = finishedAt
+	s.DurationSeconds = finishedAt.Sub(s.StartedAt).Seconds()
+	s.ExitCode = errorExitCode(err)
+	switch s.ExitCode {
+	case exitOK:
+		s.Status = summaryStatusOK
+	case exitPartial:
+		s.Status = summaryStatusPartial
+	case exitInterrupted:
+		s.Status = summaryStatusInterrupted
+	default:
+		s.Status = summaryStatusFailed
+	}
+
+	s.Games = summaryCounts{Total: len(s.Results)}
+	for _, result := range s.Results {
+		if result.ExitCode == exitOK {
+			s.Games.Succeeded++
+		} else {
+			s.Games.Failed++
+			s.Errors = append(s.Errors, result.Error)
+		}
+		s.Reviews += result.Reviews
+		s.Files = append(s.Files, result.Files...)
+	}
+	// 取得を始める前のエラーなど、どのゲームにも記録されていないエラー
+	if err != nil && len(s.Errors) == 0 {
+		s.Errors = append(s.Errors, err.Error())
+	}
+}
+
+// write 実行結果 err を集計して要約を filename に書き出し、コマンドの実行結果を返す
+//
+// 要約の書き込みに失敗した場合、err が nil であれば exitWrite で終了するエラーを返し、
+// そうでなければ失敗を標準エラー出力に表示して err をそのまま返す。
+func (s *runSummary) write(filename string, err error) error {
+	s.finish(err, time.Now())
+
+	writeErr := writeJSONFile(filename, s)
+	if writeErr == nil {
+		return err
+	}
+	writeErr = newFailure(exitWrite, errors.New(i18n.Tf(i18n.MsgErrorSummaryWrite, writeErr)))
+	if err == nil {
+		return writeErr
+	}
+	fmt.Fprintln(os.Stderr, writeErr)
+	return err
+}
+
+// writeJSONFile v をインデント付きのJSONでファイルに保存
+func writeJSONFile(filename string, v any) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(v); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
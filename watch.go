@@ -0,0 +1,219 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/y-moriya/steam-review/internal/api"
+	"github.com/y-moriya/steam-review/internal/logger"
+	"github.com/y-moriya/steam-review/internal/models"
+	"github.com/y-moriya/steam-review/internal/notify"
+	"github.com/y-moriya/steam-review/internal/storage"
+	"github.com/y-moriya/steam-review/pkg/config"
+	"github.com/y-moriya/steam-review/pkg/i18n"
+)
+
+// watchGame 監視中のゲームの保存済みレビュー
+type watchGame struct {
+	appID       string
+	filename    string              // 保存先のJSONファイル
+	reviews     []models.ReviewData // 保存済みのレビュー（作成日時の新しい順）
+	known       map[string]bool     // 保存済みのレビューID
+	gameDetails *models.GameDetails
+	saved       bool // 保存済みのJSONファイルを読み込んだかどうか
+}
+
+// loadWatchGame 保存済みのJSONファイルがあれば読み込み、監視の状態を作成
+func loadWatchGame(appID, outputDir string) (*watchGame, error) {
+	g := &watchGame{
+		appID:    appID,
+		filename: filepath.Join(outputDir, fmt.Sprintf("steam_reviews_%s%s", appID, config.FileExtJSON)),
+		known:    make(map[string]bool),
+	}
+	if _, err := os.Stat(g.filename); errors.Is(err, os.ErrNotExist) {
+		return g, nil
+	}
+
+	reviews, gameDetails, err := storage.LoadReviewsFromFile(g.filename)
+	if err != nil {
+		return nil, err
+	}
+	g.reviews = reviews
+	g.gameDetails = gameDetails
+	g.saved = true
+	for _, review := range reviews {
+		g.known[review.RecommendationID] = true
+	}
+	return g, nil
+}
+
+// merge 保存済みでないレビューだけを先頭に追加し、追加したレビューを返す
+func (g *watchGame) merge(fetched []models.ReviewData) []models.ReviewData {
+	var added []models.ReviewData
+	for _, review := range fetched {
+		if g.known[review.RecommendationID] {
+			continue
+		}
+		g.known[review.RecommendationID] = true
+		added = append(added, review)
+	}
+	if len(added) > 0 {
+		g.reviews = append(added, g.reviews...)
+	}
+	return added
+}
+
+// save レビューを一時ファイルに書き込んでから置き換える（書き込み途中のファイルを残さない）
+func (g *watchGame) save() error {
+	tmp := g.filename + ".tmp"
+	if _, err := storage.SaveReviewsToFileWithGameDetails(g.reviews, tmp, true, g.gameDetails); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, g.filename)
+}
+
+// poll 新しいレビューを取得し、あれば保存
+//
+// 取得中に ctx がキャンセルされた場合は、途中までのレビューを保存しない（次回の起動時に改めて取得する）。
+func (g *watchGame) poll(ctx context.Context, cfg config.Config, notifier *notify.Notifier, log *logger.Logger) error {
+	if g.gameDetails == nil {
+		gameDetails, err := api.GetGameDetailsContext(ctx, g.appID, log)
+		if err != nil {
+			log.Warn(i18n.Tf(i18n.MsgErrorGameDetailsInit, err), "appid", g.appID)
+		} else {
+			g.gameDetails = gameDetails
+		}
+	}
+
+	// -max は保存済みのレビューがない場合の上限。既知のレビューがあれば、それに達するまですべて取得する
+	// （上限で打ち切ると、古い側の新しいレビューが既知のレビューより後ろになり、以降も取得されなくなる）
+	maxReviews := cfg.MaxReviews
+	if g.saved || len(g.known) > 0 {
+		maxReviews = 0
+	}
+	fetched, err := api.FetchNewReviewsContext(ctx, g.appID, g.known, maxReviews, cfg.Languages, log)
+	if ctx.Err() != nil {
+		// 新しい順に取得しているため、途中までのレビューを保存すると古い側の新しいレビューが取得されなくなる
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	added := g.merge(fetched)
+	if len(added) == 0 {
+		log.Debug(i18n.Tf(i18n.MsgWatchNoNewReviews, g.appID), "appid", g.appID)
+		return nil
+	}
+
+	if err := g.save(); err != nil {
+		return errors.New(i18n.Tf(i18n.MsgErrorFileSave, err))
+	}
+	log.Info(i18n.Tf(i18n.MsgWatchNewReviews, g.appID, len(added), len(g.reviews), g.filename), "appid", g.appID, "reviews", len(added))
+	sendNotifications(ctx, notifier, g.appID, g.gameDetails, added, log)
+	return nil
+}
+
+// registerWatchFlags watch だけのフラグを登録（config show でも使用）
+func registerWatchFlags(fs *flag.FlagSet, cfg *config.Config, appIDs *string) {
+	fs.StringVar(appIDs, "appids", "", "監視するゲームのApp ID (カンマ区切り)")
+	fs.DurationVar(&cfg.WatchInterval, "interval", config.DefaultWatchInterval, "新しいレビューを確認する間隔 (例: 15m, 1h)")
+}
+
+// runWatch watch コマンド: 一定間隔で新しいレビューを取得して保存し続ける
+func runWatch(ctx context.Context, args []string) error {
+	var cfg config.Config
+	var appIDStr string
+	var languageStr string
+	var nf notifyFlags
+
+	fs := newFlagSet("watch", &cfg)
+	registerWatchFlags(fs, &cfg, &appIDStr)
+	fs.IntVar(&cfg.MaxReviews, "max", 100, "保存済みのレビューがない場合にゲームごとに取得する最大レビュー数 (0で無制限)")
+	fs.StringVar(&languageStr, "lang", "japanese", "取得する言語 (カンマ区切り, デフォルト: japanese)")
+	registerNotifyFlags(fs, &cfg, &nf)
+	if err := parseFlags(fs, args, &cfg); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return newUsageError(i18n.Tf(i18n.MsgErrorUnknownCommand, fs.Arg(0)))
+	}
+
+	cfg.AppIDs = ParseLanguages(appIDStr)
+	cfg.Languages = ParseLanguages(languageStr)
+	parseNotifyFlags(&cfg, nf)
+	if len(cfg.AppIDs) == 0 {
+		return newUsageError(i18n.T(i18n.MsgErrorWatchNoAppIDs))
+	}
+	if err := cfg.Validate(); err != nil {
+		return usageError{err: err}
+	}
+	cfg.Languages = config.NormalizeLanguages(cfg.Languages)
+	notifier, err := newNotifier(cfg)
+	if err != nil {
+		return err
+	}
+
+	log, err := newLogger(cfg)
+	if err != nil {
+		return err
+	}
+	defer log.Close()
+	if notifier != nil {
+		notifier.SetLogger(log)
+	}
+
+	if err := os.MkdirAll(cfg.OutputDir, 0755); err != nil {
+		return logError(log, newFailure(exitWrite, errors.New(i18n.Tf(i18n.MsgErrorDirCreation, err))))
+	}
+
+	// 保存済みのレビューを読み込む（同じApp IDの重複指定は1つにまとめる）
+	var games []*watchGame
+	seen := make(map[string]bool)
+	for _, appID := range cfg.AppIDs {
+		if seen[appID] {
+			continue
+		}
+		seen[appID] = true
+		g, err := loadWatchGame(appID, cfg.OutputDir)
+		if err != nil {
+			return logError(log, errors.New(i18n.Tf(i18n.MsgErrorCompareTarget, appID, err)))
+		}
+		if len(g.reviews) > 0 {
+			log.Debug(i18n.Tf(i18n.MsgWatchLoaded, appID, len(g.reviews), g.filename), "appid", appID)
+		}
+		games = append(games, g)
+	}
+
+	// SIGINT・SIGTERM で ctx がキャンセルされたら、処理中のゲームの保存を終えてから終了する
+	stopping := make(chan struct{})
+	context.AfterFunc(ctx, func() {
+		log.Info(i18n.T(i18n.MsgWatchStopping))
+		close(stopping)
+	})
+
+	log.Info(i18n.Tf(i18n.MsgWatchStarted, len(games), cfg.WatchInterval))
+	for {
+		for _, g := range games {
+			if ctx.Err() != nil {
+				break
+			}
+			if err := g.poll(ctx, cfg, notifier, log); err != nil {
+				// 一時的な通信エラーなどで監視を止めず、次の確認時に再試行する
+				log.Error(i18n.Tf(i18n.MsgErrorWatchPoll, g.appID, err), "appid", g.appID)
+			}
+		}
+
+		select {
+		case <-stopping:
+			log.Info(i18n.T(i18n.MsgWatchStopped))
+			return nil
+		case <-time.After(cfg.WatchInterval):
+		}
+	}
+}